@@ -0,0 +1,831 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+// environment is what a command runs against.
+type environment struct {
+	client *vinyldns.Client
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+// runFunc runs a command with its positional args. It returns the output to
+// write in the selected format, or nil if the command wrote its own output.
+type runFunc func(env *environment, args []string) (*output, error)
+
+// command is a vinyldns subcommand, such as "zones list".
+type command struct {
+	name    string
+	args    string
+	summary string
+
+	// setup registers the command's flags on fs and returns the function that runs it.
+	setup func(fs *flag.FlagSet) runFunc
+}
+
+// noFlags adapts a runFunc taking no flags to a command's setup.
+func noFlags(run runFunc) func(*flag.FlagSet) runFunc {
+	return func(*flag.FlagSet) runFunc { return run }
+}
+
+var commands = []command{
+	{
+		name:    "zones list",
+		summary: "List zones",
+		setup: func(fs *flag.FlagSet) runFunc {
+			filter := fs.String("filter", "", "zone name filter")
+			adminGroup := fs.Bool("admin-group", false, "match --filter against the zones' admin group names")
+			all := fs.Bool("all", false, "list every zone, including those you have no access to")
+			noReverse := fs.Bool("no-reverse", false, "exclude reverse zones")
+			return func(env *environment, args []string) (*output, error) {
+				if len(args) != 0 {
+					return nil, errUsage
+				}
+				f := vinyldns.ZoneListFilter{NameFilter: *filter, SearchByAdminGroup: *adminGroup}
+				if *all {
+					f.IgnoreAccess = all
+				}
+				if *noReverse {
+					includeReverse := false
+					f.IncludeReverse = &includeReverse
+				}
+				zones, err := env.client.ZonesListAllFiltered(f)
+				if err != nil {
+					return nil, err
+				}
+				return zonesOutput(zones), nil
+			}
+		},
+	},
+	{
+		name:    "zones get",
+		args:    "<zone>",
+		summary: "Show a zone, by ID or by name",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			zone, err := resolveZone(env.client, args[0])
+			if err != nil {
+				return nil, err
+			}
+			return zonesOutput([]vinyldns.Zone{zone}).with(zone), nil
+		}),
+	},
+	{
+		name:    "zones changes",
+		args:    "<zone>",
+		summary: "List a zone's changes",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			zone, err := resolveZone(env.client, args[0])
+			if err != nil {
+				return nil, err
+			}
+			changes, err := env.client.ZoneChangesListAll(zone.ID, vinyldns.ListFilter{})
+			if err != nil {
+				return nil, err
+			}
+			out := &output{value: changes, headers: []string{"ID", "CHANGE TYPE", "STATUS", "CREATED", "MESSAGE"}}
+			for _, c := range changes {
+				out.rows = append(out.rows, []string{c.ID, c.ChangeType, c.Status, c.Created, c.SystemMessage})
+			}
+			return out, nil
+		}),
+	},
+	{
+		name:    "zones create",
+		args:    "<name>",
+		summary: "Create a zone",
+		setup: func(fs *flag.FlagSet) runFunc {
+			email := fs.String("email", "", "zone contact email (required)")
+			adminGroup := fs.String("admin-group", "", "admin group ID (required)")
+			backendID := fs.String("backend-id", "", "ID of the DNS backend to connect the zone to")
+			shared := fs.Bool("shared", false, "make the zone shared")
+			return func(env *environment, args []string) (*output, error) {
+				if len(args) != 1 || *email == "" || *adminGroup == "" {
+					return nil, errUsage
+				}
+				resp, err := env.client.ZoneCreate(&vinyldns.Zone{
+					Name:         args[0],
+					Email:        *email,
+					AdminGroupID: *adminGroup,
+					BackendID:    *backendID,
+					Shared:       *shared,
+				})
+				if err != nil {
+					return nil, err
+				}
+				return zoneChangeOutput(resp.ID, resp.ChangeType, resp.Status, resp.Zone).with(resp), nil
+			}
+		},
+	},
+	{
+		name:    "zones sync",
+		args:    "<zone>",
+		summary: "Sync a zone with its DNS backend",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			zone, err := resolveZone(env.client, args[0])
+			if err != nil {
+				return nil, err
+			}
+			change, err := env.client.ZoneSync(zone.ID)
+			if err != nil {
+				return nil, err
+			}
+			return zoneChangeOutput(change.ID, change.ChangeType, change.Status, change.Zone).with(change), nil
+		}),
+	},
+	{
+		name:    "zones delete",
+		args:    "<zone>",
+		summary: "Delete a zone",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			zone, err := resolveZone(env.client, args[0])
+			if err != nil {
+				return nil, err
+			}
+			resp, err := env.client.ZoneDelete(zone.ID)
+			if err != nil {
+				return nil, err
+			}
+			return zoneChangeOutput(resp.ID, resp.ChangeType, resp.Status, resp.Zone).with(resp), nil
+		}),
+	},
+	{
+		name:    "zones export",
+		args:    "<zone>",
+		summary: "Write a zone as an RFC 1035 master file",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			zone, err := resolveZone(env.client, args[0])
+			if err != nil {
+				return nil, err
+			}
+			return nil, env.client.ZoneFileExport(zone.ID, env.stdout)
+		}),
+	},
+	{
+		name:    "zones import",
+		args:    "<zone> <file>",
+		summary: "Create the record sets of an RFC 1035 master file in a zone; \"-\" reads standard input",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 2 {
+				return nil, errUsage
+			}
+			zone, err := resolveZone(env.client, args[0])
+			if err != nil {
+				return nil, err
+			}
+
+			r, closeInput, err := openInput(env, args[1])
+			if err != nil {
+				return nil, err
+			}
+			defer closeInput()
+
+			result, err := env.client.ZoneFileImport(zone.ID, r, vinyldns.ZoneFileParseOptions{})
+			if err != nil {
+				return nil, err
+			}
+			out := &output{value: result, headers: []string{"NAME", "TYPE", "RESULT"}}
+			for _, resp := range result.Created {
+				out.rows = append(out.rows, []string{resp.RecordSet.Name, resp.RecordSet.Type, "created"})
+			}
+			for _, rs := range result.Skipped {
+				out.rows = append(out.rows, []string{rs.Name, rs.Type, "skipped"})
+			}
+			for _, f := range result.Failed {
+				out.rows = append(out.rows, []string{f.RecordSet.Name, f.RecordSet.Type, "failed: " + f.Err.Error()})
+			}
+			if len(result.Failed) != 0 {
+				return out, fmt.Errorf("%d of %d record sets failed to import", len(result.Failed), len(result.Created)+len(result.Failed))
+			}
+			return out, nil
+		}),
+	},
+	{
+		name:    "recordsets list",
+		args:    "<zone>",
+		summary: "List a zone's record sets",
+		setup: func(fs *flag.FlagSet) runFunc {
+			filter := fs.String("filter", "", "record name filter")
+			recordType := fs.String("type", "", "record type filter; a comma-separated list of types")
+			ownerGroup := fs.String("owner-group", "", "record owner group ID filter")
+			desc := fs.Bool("desc", false, "sort by name in descending order")
+			return func(env *environment, args []string) (*output, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				zone, err := resolveZone(env.client, args[0])
+				if err != nil {
+					return nil, err
+				}
+				f := vinyldns.ZoneRecordSetFilter{
+					RecordNameFilter:       *filter,
+					RecordTypeFilter:       strings.ToUpper(*recordType),
+					RecordOwnerGroupFilter: *ownerGroup,
+				}
+				if *desc {
+					f.NameSort = vinyldns.DESC
+				}
+				recordSets, err := env.client.RecordSetsListAllFiltered(zone.ID, f)
+				if err != nil {
+					return nil, err
+				}
+				return recordSetsOutput(recordSets), nil
+			}
+		},
+	},
+	{
+		name:    "recordsets search",
+		args:    "<name-filter>",
+		summary: "Search the record sets of all zones by name",
+		setup: func(fs *flag.FlagSet) runFunc {
+			recordType := fs.String("type", "", "record type filter")
+			return func(env *environment, args []string) (*output, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				recordSets, err := env.client.RecordSetsGlobalListAll(vinyldns.GlobalListFilter{
+					RecordNameFilter: args[0],
+					RecordTypeFilter: strings.ToUpper(*recordType),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return recordSetsOutput(recordSets), nil
+			}
+		},
+	},
+	{
+		name:    "recordsets get",
+		args:    "<zone> <record-set-id>",
+		summary: "Show a record set",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 2 {
+				return nil, errUsage
+			}
+			zone, err := resolveZone(env.client, args[0])
+			if err != nil {
+				return nil, err
+			}
+			rs, err := env.client.RecordSet(zone.ID, args[1])
+			if err != nil {
+				return nil, err
+			}
+			return recordSetsOutput([]vinyldns.RecordSet{rs}).with(rs), nil
+		}),
+	},
+	{
+		name:    "recordsets create",
+		args:    "<zone> <name> <type> <ttl> <rdata>...",
+		summary: "Create a record set; each <rdata> is one record in master file syntax",
+		setup: func(fs *flag.FlagSet) runFunc {
+			ownerGroup := fs.String("owner-group", "", "owner group ID")
+			return func(env *environment, args []string) (*output, error) {
+				if len(args) < 5 {
+					return nil, errUsage
+				}
+				zone, err := resolveZone(env.client, args[0])
+				if err != nil {
+					return nil, err
+				}
+				rs, err := parseRecordSet(zone, args[1], args[2], args[3], args[4:])
+				if err != nil {
+					return nil, err
+				}
+				rs.OwnerGroupID = *ownerGroup
+				resp, err := env.client.RecordSetCreate(&rs)
+				if err != nil {
+					return nil, err
+				}
+				return recordSetChangeOutput(resp), nil
+			}
+		},
+	},
+	{
+		name:    "recordsets delete",
+		args:    "<zone> <record-set-id>",
+		summary: "Delete a record set",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 2 {
+				return nil, errUsage
+			}
+			zone, err := resolveZone(env.client, args[0])
+			if err != nil {
+				return nil, err
+			}
+			resp, err := env.client.RecordSetDelete(zone.ID, args[1])
+			if err != nil {
+				return nil, err
+			}
+			return recordSetChangeOutput(resp), nil
+		}),
+	},
+	{
+		name:    "groups list",
+		summary: "List groups",
+		setup: func(fs *flag.FlagSet) runFunc {
+			filter := fs.String("filter", "", "group name filter")
+			return func(env *environment, args []string) (*output, error) {
+				if len(args) != 0 {
+					return nil, errUsage
+				}
+				groups, err := env.client.GroupsListAll(vinyldns.ListFilter{NameFilter: *filter})
+				if err != nil {
+					return nil, err
+				}
+				return groupsOutput(groups), nil
+			}
+		},
+	},
+	{
+		name:    "groups create",
+		args:    "<name>",
+		summary: "Create a group",
+		setup: func(fs *flag.FlagSet) runFunc {
+			email := fs.String("email", "", "group contact email (required)")
+			description := fs.String("description", "", "group description")
+			members := fs.String("members", "", "comma-separated member user IDs")
+			admins := fs.String("admins", "", "comma-separated admin user IDs, who are also made members")
+			return func(env *environment, args []string) (*output, error) {
+				if len(args) != 1 || *email == "" {
+					return nil, errUsage
+				}
+				group := &vinyldns.Group{
+					Name:        args[0],
+					Email:       *email,
+					Description: *description,
+					Members:     []vinyldns.User{},
+					Admins:      []vinyldns.User{},
+				}
+				adminIDs := splitList(*admins)
+				for _, id := range adminIDs {
+					group.Admins = append(group.Admins, vinyldns.User{ID: id})
+					group.Members = append(group.Members, vinyldns.User{ID: id})
+				}
+				for _, id := range splitList(*members) {
+					if !slices.Contains(adminIDs, id) {
+						group.Members = append(group.Members, vinyldns.User{ID: id})
+					}
+				}
+				created, err := env.client.GroupCreate(group)
+				if err != nil {
+					return nil, err
+				}
+				return groupsOutput([]vinyldns.Group{*created}).with(created), nil
+			}
+		},
+	},
+	{
+		name:    "groups get",
+		args:    "<group-id>",
+		summary: "Show a group",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			group, err := env.client.Group(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return groupsOutput([]vinyldns.Group{*group}).with(group), nil
+		}),
+	},
+	{
+		name:    "groups members",
+		args:    "<group-id>",
+		summary: "List a group's members",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			users, err := env.client.GroupMembers(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return usersOutput(users), nil
+		}),
+	},
+	{
+		name:    "groups admins",
+		args:    "<group-id>",
+		summary: "List a group's admins",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			users, err := env.client.GroupAdmins(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return usersOutput(users), nil
+		}),
+	},
+	{
+		name:    "groups activity",
+		args:    "<group-id>",
+		summary: "List a group's changes",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			activity, err := env.client.GroupActivity(args[0])
+			if err != nil {
+				return nil, err
+			}
+			out := &output{value: activity, headers: []string{"ID", "CHANGE TYPE", "USER", "CREATED", "MESSAGE"}}
+			for _, c := range activity.Changes {
+				out.rows = append(out.rows, []string{c.ID, c.ChangeType, c.UserName, c.Created, c.GroupChangeMessage})
+			}
+			return out, nil
+		}),
+	},
+	{
+		name:    "users get",
+		args:    "<user-id-or-name>",
+		summary: "Show a user",
+		setup: noFlags(userCommand(func(c *vinyldns.Client, id string) (vinyldns.UserInfo, error) {
+			return c.User(id)
+		})),
+	},
+	{
+		name:    "users lock",
+		args:    "<user-id>",
+		summary: "Lock a user",
+		setup: noFlags(userCommand(func(c *vinyldns.Client, id string) (vinyldns.UserInfo, error) {
+			return c.UserLock(id)
+		})),
+	},
+	{
+		name:    "users unlock",
+		args:    "<user-id>",
+		summary: "Unlock a user",
+		setup: noFlags(userCommand(func(c *vinyldns.Client, id string) (vinyldns.UserInfo, error) {
+			return c.UserUnlock(id)
+		})),
+	},
+	{
+		name:    "batch list",
+		summary: "List batch changes",
+		setup: func(fs *flag.FlagSet) runFunc {
+			status := fs.String("status", "", "approval status filter, such as PendingReview")
+			user := fs.String("user", "", "submitting user name filter")
+			group := fs.String("group", "", "owner group name filter")
+			all := fs.Bool("all", false, "list every user's batch changes")
+			scheduled := fs.Bool("scheduled", false, "list only scheduled batch changes that have yet to be applied")
+			return func(env *environment, args []string) (*output, error) {
+				if len(args) != 0 {
+					return nil, errUsage
+				}
+				f := vinyldns.BatchRecordChangesFilter{ApprovalStatus: *status, UserName: *user, GroupName: *group}
+				if *all {
+					f.IgnoreAccess = all
+				}
+				list := env.client.BatchRecordChangesListAll
+				if *scheduled {
+					list = env.client.BatchRecordChangesScheduled
+				}
+				changes, err := list(f)
+				if err != nil {
+					return nil, err
+				}
+				out := &output{value: changes, headers: []string{"ID", "USER", "STATUS", "APPROVAL", "CHANGES", "CREATED", "SCHEDULED", "COMMENTS"}}
+				for _, c := range changes {
+					out.rows = append(out.rows, []string{c.ID, c.UserName, c.Status, c.ApprovalStatus, strconv.Itoa(c.TotalChanges), c.CreatedTimestamp, c.ScheduledTime, c.Comments})
+				}
+				return out, nil
+			}
+		},
+	},
+	{
+		name:    "batch get",
+		args:    "<batch-change-id>",
+		summary: "Show a batch change and its single changes",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			change, err := env.client.BatchRecordChange(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return batchChangeOutput(change), nil
+		}),
+	},
+	{
+		name:    "batch create",
+		args:    "<file>",
+		summary: "Create a batch change from a JSON file, in the format of \"batch get -o json\"; \"-\" reads standard input",
+		setup: func(fs *flag.FlagSet) runFunc {
+			comments := fs.String("comments", "", "batch change comments; overrides the file's")
+			ownerGroup := fs.String("owner-group", "", "owner group ID; overrides the file's")
+			scheduled := fs.String("scheduled", "", "RFC 3339 time to schedule the batch change for")
+			noReview := fs.Bool("no-manual-review", false, "reject the batch change rather than hold it for manual review")
+			return func(env *environment, args []string) (*output, error) {
+				if len(args) != 1 {
+					return nil, errUsage
+				}
+				r, closeInput, err := openInput(env, args[0])
+				if err != nil {
+					return nil, err
+				}
+				defer closeInput()
+
+				change := &vinyldns.BatchRecordChange{}
+				if err := json.NewDecoder(r).Decode(change); err != nil {
+					return nil, fmt.Errorf("%s: %w", args[0], err)
+				}
+				if *comments != "" {
+					change.Comments = *comments
+				}
+				if *ownerGroup != "" {
+					change.OwnerGroupID = *ownerGroup
+				}
+
+				opts := &vinyldns.BatchChangeCreateOptions{}
+				if *scheduled != "" {
+					if opts.ScheduledTime, err = time.Parse(time.RFC3339, *scheduled); err != nil {
+						return nil, fmt.Errorf("invalid --scheduled time %q; want RFC 3339, such as 2026-01-02T15:04:05Z", *scheduled)
+					}
+				}
+				if *noReview {
+					allow := false
+					opts.AllowManualReview = &allow
+				}
+
+				resp, err := env.client.BatchRecordChangeSubmit(change, opts)
+				if err != nil {
+					return nil, err
+				}
+				return &output{
+					value:   resp,
+					headers: []string{"ID", "STATUS", "APPROVAL", "CHANGES", "CREATED", "COMMENTS"},
+					rows:    [][]string{{resp.ID, resp.Status, resp.ApprovalStatus, strconv.Itoa(len(resp.Changes)), resp.CreatedTimestamp, resp.Comments}},
+				}, nil
+			}
+		},
+	},
+	{
+		name:    "batch approve",
+		args:    "<batch-change-id>",
+		summary: "Approve a batch change pending review",
+		setup:   reviewCommand((*vinyldns.Client).BatchRecordChangeApprove),
+	},
+	{
+		name:    "batch reject",
+		args:    "<batch-change-id>",
+		summary: "Reject a batch change pending review",
+		setup:   reviewCommand((*vinyldns.Client).BatchRecordChangeReject),
+	},
+	{
+		name:    "batch cancel",
+		args:    "<batch-change-id>",
+		summary: "Cancel a batch change pending review",
+		setup:   reviewCommand((*vinyldns.Client).BatchRecordChangeCancel),
+	},
+	{
+		name:    "status",
+		summary: "Show the VinylDNS system status",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 0 {
+				return nil, errUsage
+			}
+			status, err := env.client.Status()
+			if err != nil {
+				return nil, err
+			}
+			return &output{
+				value:   status,
+				headers: []string{"PROCESSING DISABLED", "COLOR", "KEY NAME", "VERSION"},
+				rows:    [][]string{{strconv.FormatBool(status.ProcessingDisabled), status.Color, status.KeyName, status.Version}},
+			}, nil
+		}),
+	},
+	{
+		name:    "health",
+		summary: "Check the health of the VinylDNS API",
+		setup: noFlags(func(env *environment, args []string) (*output, error) {
+			if len(args) != 0 {
+				return nil, errUsage
+			}
+			if err := env.client.Health(); err != nil {
+				return nil, err
+			}
+			return &output{
+				value:   map[string]string{"status": "healthy"},
+				headers: []string{"STATUS"},
+				rows:    [][]string{{"healthy"}},
+			}, nil
+		}),
+	},
+}
+
+// with returns the output with its json and yaml value replaced by v,
+// so that commands showing a single resource don't write a list.
+func (o *output) with(v interface{}) *output {
+	o.value = v
+	return o
+}
+
+// resolveZone returns the zone named by ref, which is a zone name
+// if it contains a dot, and a zone ID otherwise.
+func resolveZone(c *vinyldns.Client, ref string) (vinyldns.Zone, error) {
+	if strings.Contains(ref, ".") {
+		return c.ZoneByName(ref)
+	}
+
+	return c.Zone(ref)
+}
+
+// openInput opens the file named by a command's arg, or standard input if it's "-",
+// returning the function that closes it.
+func openInput(env *environment, name string) (io.Reader, func(), error) {
+	if name == "-" {
+		return env.stdin, func() {}, nil
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return f, func() { f.Close() }, nil
+}
+
+// splitList returns the elements of a comma-separated flag value.
+func splitList(list string) []string {
+	elements := []string{}
+	for _, e := range strings.Split(list, ",") {
+		if e = strings.TrimSpace(e); e != "" {
+			elements = append(elements, e)
+		}
+	}
+
+	return elements
+}
+
+// parseRecordSet returns the record set described by the command line fields
+// it's passed, parsing each rdata as the RDATA of a master file record.
+func parseRecordSet(zone vinyldns.Zone, name, recordType, ttl string, rdata []string) (vinyldns.RecordSet, error) {
+	if _, err := vinyldns.ParseRecordType(recordType); err != nil {
+		return vinyldns.RecordSet{}, err
+	}
+	if _, err := strconv.Atoi(ttl); err != nil {
+		return vinyldns.RecordSet{}, fmt.Errorf("invalid TTL %q", ttl)
+	}
+
+	var b strings.Builder
+	for _, r := range rdata {
+		fmt.Fprintf(&b, "%s %s IN %s %s\n", name, ttl, recordType, r)
+	}
+	recordSets, err := vinyldns.ParseZoneFile(strings.NewReader(b.String()), vinyldns.ZoneFileParseOptions{Origin: zone.Name})
+	if err != nil {
+		return vinyldns.RecordSet{}, err
+	}
+
+	rs := recordSets[0]
+	rs.ZoneID = zone.ID
+
+	return rs, nil
+}
+
+func userCommand(get func(*vinyldns.Client, string) (vinyldns.UserInfo, error)) runFunc {
+	return func(env *environment, args []string) (*output, error) {
+		if len(args) != 1 {
+			return nil, errUsage
+		}
+		user, err := get(env.client, args[0])
+		if err != nil {
+			return nil, err
+		}
+		return &output{
+			value:   user,
+			headers: []string{"ID", "USERNAME", "LOCK STATUS", "GROUPS"},
+			rows:    [][]string{{user.ID, user.UserName, user.LockStatus, strings.Join(user.GroupID, ",")}},
+		}, nil
+	}
+}
+
+func reviewCommand(review func(*vinyldns.Client, string, *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error)) func(*flag.FlagSet) runFunc {
+	return func(fs *flag.FlagSet) runFunc {
+		comment := fs.String("comment", "", "review comment")
+		return func(env *environment, args []string) (*output, error) {
+			if len(args) != 1 {
+				return nil, errUsage
+			}
+			change, err := review(env.client, args[0], &vinyldns.BatchChangeReview{ReviewComment: *comment})
+			if err != nil {
+				return nil, err
+			}
+			return batchChangeOutput(change), nil
+		}
+	}
+}
+
+func zonesOutput(zones []vinyldns.Zone) *output {
+	out := &output{value: zones, headers: []string{"ID", "NAME", "EMAIL", "STATUS", "ADMIN GROUP"}}
+	for _, z := range zones {
+		out.rows = append(out.rows, []string{z.ID, z.Name, z.Email, z.Status, z.AdminGroupID})
+	}
+
+	return out
+}
+
+func zoneChangeOutput(id, changeType, status string, zone vinyldns.Zone) *output {
+	return &output{
+		headers: []string{"CHANGE ID", "CHANGE TYPE", "STATUS", "ZONE"},
+		rows:    [][]string{{id, changeType, status, zone.Name}},
+	}
+}
+
+func recordSetsOutput(recordSets []vinyldns.RecordSet) *output {
+	out := &output{value: recordSets, headers: []string{"ID", "NAME", "TYPE", "TTL", "RECORDS"}}
+	for _, rs := range recordSets {
+		records := make([]string, len(rs.Records))
+		for i, r := range rs.Records {
+			rdata, err := vinyldns.FormatRecordData(vinyldns.RecordType(rs.Type), r)
+			if err != nil {
+				rdata = "?"
+			}
+			records[i] = rdata
+		}
+		out.rows = append(out.rows, []string{rs.ID, rs.Name, rs.Type, strconv.Itoa(rs.TTL), strings.Join(records, ", ")})
+	}
+
+	return out
+}
+
+func recordSetChangeOutput(resp *vinyldns.RecordSetUpdateResponse) *output {
+	return &output{
+		value:   resp,
+		headers: []string{"CHANGE ID", "STATUS", "RECORD SET ID", "NAME", "TYPE"},
+		rows:    [][]string{{resp.ChangeID, resp.Status, resp.RecordSet.ID, resp.RecordSet.Name, resp.RecordSet.Type}},
+	}
+}
+
+func groupsOutput(groups []vinyldns.Group) *output {
+	out := &output{value: groups, headers: []string{"ID", "NAME", "EMAIL", "STATUS", "DESCRIPTION"}}
+	for _, g := range groups {
+		out.rows = append(out.rows, []string{g.ID, g.Name, g.Email, g.Status, g.Description})
+	}
+
+	return out
+}
+
+func usersOutput(users []vinyldns.User) *output {
+	out := &output{value: users, headers: []string{"ID", "USERNAME", "NAME", "EMAIL"}}
+	for _, u := range users {
+		out.rows = append(out.rows, []string{u.ID, u.UserName, strings.TrimSpace(u.FirstName + " " + u.LastName), u.Email})
+	}
+
+	return out
+}
+
+func batchChangeOutput(change *vinyldns.BatchRecordChange) *output {
+	out := &output{value: change, headers: []string{"INPUT NAME", "TYPE", "CHANGE TYPE", "STATUS", "RECORD"}}
+	for _, c := range change.Changes {
+		record := ""
+		if c.Record != (vinyldns.RecordData{}) {
+			// Changes of unknown types are listed without their data.
+			record, _ = vinyldns.FormatRecordData(vinyldns.RecordType(c.Type), c.Record.Record())
+		}
+		out.rows = append(out.rows, []string{c.InputName, c.Type, c.ChangeType, c.Status, record})
+	}
+
+	return out
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command vinyldns is a command-line client for the VinylDNS API.
+//
+// Usage:
+//
+//	vinyldns [flags] <resource> <command> [flags] [args]
+//
+// The client is configured by vinyldns.LoadConfig, from the profile of
+// ~/.vinyldns/config named by --profile or VINYLDNS_PROFILE, overridden by
+// the VINYLDNS_* environment variables, in turn overridden by the --host,
+// --access-key and --secret-key flags.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+// version is set at build time with -ldflags "-X main.version=...".
+var version = "dev"
+
+// Exit codes returned by the command.
+const (
+	exitOK           = 0
+	exitError        = 1
+	exitUsage        = 2
+	exitNotFound     = 3
+	exitServerError  = 4
+	exitUnauthorized = 5
+)
+
+// errUsage is returned by commands invoked with the wrong arguments.
+var errUsage = errors.New("usage")
+
+// options holds the flags accepted by every command.
+type options struct {
+	output    string
+	profile   string
+	host      string
+	accessKey string
+	secretKey string
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs the command line it's passed and returns the process exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	opts := &options{output: "table"}
+
+	fs := flag.NewFlagSet("vinyldns", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	opts.register(fs)
+	showVersion := fs.Bool("version", false, "print the version and exit")
+	fs.Usage = func() { usage(stderr) }
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if *showVersion {
+		fmt.Fprintf(stdout, "vinyldns %s (go-vinyldns %s)\n", version, vinyldns.Version)
+		return exitOK
+	}
+
+	args = fs.Args()
+	cmd, args := findCommand(args)
+	if cmd == nil {
+		usage(stderr)
+		return exitUsage
+	}
+
+	// The flags accepted by every command may also follow it.
+	cmdOpts := &options{}
+	cfs := flag.NewFlagSet("vinyldns "+cmd.name, flag.ContinueOnError)
+	cfs.SetOutput(stderr)
+	cmdOpts.register(cfs)
+	exec := cmd.setup(cfs)
+	cfs.Usage = func() {
+		fmt.Fprintf(stderr, "Usage: vinyldns %s [flags] %s\n\n%s.\n\nFlags:\n", cmd.name, cmd.args, cmd.summary)
+		cfs.PrintDefaults()
+	}
+	args, err := parseInterspersed(cfs, args)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	opts.merge(cmdOpts)
+
+	format, ok := formats[opts.output]
+	if !ok {
+		fmt.Fprintf(stderr, "vinyldns: unknown output format %q; want table, json or yaml\n", opts.output)
+		return exitUsage
+	}
+
+	client, err := opts.client()
+	if err != nil {
+		fmt.Fprintf(stderr, "vinyldns: %s\n", err)
+		return exitError
+	}
+	env := &environment{
+		client: client,
+		stdin:  stdin,
+		stdout: stdout,
+	}
+	out, err := exec(env, args)
+	if errors.Is(err, errUsage) {
+		cfs.Usage()
+		return exitUsage
+	}
+
+	// A command may fail having produced output, such as an import's per-record results.
+	if out != nil {
+		if err := format(stdout, out); err != nil {
+			fmt.Fprintf(stderr, "vinyldns: %s\n", err)
+			return exitError
+		}
+	}
+	if err != nil {
+		fmt.Fprintf(stderr, "vinyldns: %s\n", err)
+		return exitCode(err)
+	}
+
+	return exitOK
+}
+
+// register registers the flags accepted by every command on fs,
+// with the options' current values as their defaults.
+func (o *options) register(fs *flag.FlagSet) {
+	fs.StringVar(&o.output, "output", o.output, "output format: table, json or yaml")
+	fs.StringVar(&o.output, "o", o.output, "shorthand for --output")
+	fs.StringVar(&o.profile, "profile", o.profile, "config file profile; overrides VINYLDNS_PROFILE")
+	fs.StringVar(&o.host, "host", o.host, "VinylDNS API URL; overrides VINYLDNS_HOST")
+	fs.StringVar(&o.accessKey, "access-key", o.accessKey, "access key; overrides VINYLDNS_ACCESS_KEY")
+	fs.StringVar(&o.secretKey, "secret-key", o.secretKey, "secret key; overrides VINYLDNS_SECRET_KEY")
+}
+
+// merge sets the options that are set in other.
+func (o *options) merge(other *options) {
+	for _, f := range []struct{ dst, src *string }{
+		{&o.output, &other.output},
+		{&o.profile, &other.profile},
+		{&o.host, &other.host},
+		{&o.accessKey, &other.accessKey},
+		{&o.secretKey, &other.secretKey},
+	} {
+		if *f.src != "" {
+			*f.dst = *f.src
+		}
+	}
+}
+
+// parseInterspersed parses the flags in args, which may be interspersed with
+// positional args, and returns the positional args. Everything after a "--"
+// is a positional arg.
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	positional := []string{}
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		consumed := len(args) - len(fs.Args())
+		if consumed > 0 && args[consumed-1] == "--" {
+			return append(positional, fs.Args()...), nil
+		}
+
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// client returns a client configured from the config file, the environment and the options.
+func (o *options) client() (*vinyldns.Client, error) {
+	profile := o.profile
+	if profile == "" {
+		profile = os.Getenv("VINYLDNS_PROFILE")
+	}
+	config, err := vinyldns.LoadConfigProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+	if o.host != "" {
+		config.Host = o.host
+	}
+	if o.accessKey != "" {
+		config.AccessKey = o.accessKey
+	}
+	if o.secretKey != "" {
+		config.SecretKey = o.secretKey
+	}
+	config.UserAgent = fmt.Sprintf("vinyldns-cli/%s %s", version, config.UserAgent)
+
+	return vinyldns.NewClient(config), nil
+}
+
+// exitCode returns the exit code for the error a command failed with.
+func exitCode(err error) int {
+	var vErr *vinyldns.Error
+	switch {
+	case errors.Is(err, vinyldns.ErrNotFound):
+		return exitNotFound
+	case errors.Is(err, vinyldns.ErrUnauthorized), errors.Is(err, vinyldns.ErrForbidden):
+		return exitUnauthorized
+	case errors.As(err, &vErr) && vErr.ResponseCode >= http.StatusInternalServerError:
+		return exitServerError
+	}
+
+	return exitError
+}
+
+// findCommand returns the command named by the leading args,
+// along with the remaining args, or nil if there is none.
+func findCommand(args []string) (*command, []string) {
+	for i := range commands {
+		words := strings.Fields(commands[i].name)
+		if len(args) >= len(words) && strings.Join(args[:len(words)], " ") == commands[i].name {
+			return &commands[i], args[len(words):]
+		}
+	}
+
+	return nil, args
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: vinyldns [flags] <resource> <command> [flags] [args]\n\nCommands:\n")
+
+	names := make([]string, len(commands))
+	width := 0
+	for i, c := range commands {
+		names[i] = strings.TrimSpace(c.name + " " + c.args)
+		if len(names[i]) > width {
+			width = len(names[i])
+		}
+	}
+	for i, c := range commands {
+		fmt.Fprintf(w, "  %-*s  %s\n", width, names[i], c.summary)
+	}
+
+	fmt.Fprintf(w, `
+Flags:
+  -o, --output      output format: table, json or yaml (default table)
+  --profile         config file profile; overrides VINYLDNS_PROFILE
+  --host            VinylDNS API URL; overrides VINYLDNS_HOST
+  --access-key      access key; overrides VINYLDNS_ACCESS_KEY
+  --secret-key      secret key; overrides VINYLDNS_SECRET_KEY
+  --version         print the version and exit
+
+Exit codes:
+  0  success
+  1  error
+  2  usage error
+  3  not found
+  4  server error
+  5  unauthorized or forbidden
+`)
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+	"github.com/vinyldns/go-vinyldns/vinyldns/vinyldnstest"
+)
+
+// testRun runs the command line against the fake server,
+// returning its exit code, stdout and stderr.
+func testRun(t *testing.T, s *vinyldnstest.Server, stdin string, args ...string) (int, string, string) {
+	t.Helper()
+	t.Setenv("VINYLDNS_CONFIG_FILE", filepath.Join(t.TempDir(), "config"))
+
+	var stdout, stderr bytes.Buffer
+	args = append([]string{"--host", s.URL, "--access-key", "key", "--secret-key", "secret"}, args...)
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+
+	return code, stdout.String(), stderr.String()
+}
+
+func testServer(t *testing.T) (*vinyldnstest.Server, vinyldns.Zone) {
+	s := vinyldnstest.NewServer()
+	g := s.AddGroup(vinyldns.Group{Name: "ok-group", Email: "test@example.com"})
+	zone := s.AddZone(vinyldns.Zone{Name: "ok.", Email: "test@example.com", AdminGroupID: g.ID})
+	if _, err := s.AddRecordSet(vinyldns.NewRecordSet(zone.ID, "www", vinyldns.RecordTypeA, 300, vinyldns.NewARecord("10.0.0.1"))); err != nil {
+		t.Fatal(err)
+	}
+
+	return s, zone
+}
+
+func TestZonesList(t *testing.T) {
+	s, zone := testServer(t)
+	defer s.Close()
+
+	code, stdout, stderr := testRun(t, s, "", "zones", "list")
+	if code != exitOK {
+		t.Fatalf("Expected exit code 0; got %d: %s", code, stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 2 || !strings.HasPrefix(lines[0], "ID") || !strings.Contains(lines[1], zone.ID) || !strings.Contains(lines[1], "ok.") {
+		t.Errorf("Unexpected table:\n%s", stdout)
+	}
+
+	code, stdout, _ = testRun(t, s, "", "zones", "list", "-o", "json")
+	zones := []vinyldns.Zone{}
+	if err := json.Unmarshal([]byte(stdout), &zones); err != nil || code != exitOK {
+		t.Fatalf("Expected JSON zones; got %d %v:\n%s", code, err, stdout)
+	}
+	if len(zones) != 1 || zones[0].ID != zone.ID {
+		t.Errorf("Unexpected zones %+v", zones)
+	}
+}
+
+func TestRecordSetsCreateAndList(t *testing.T) {
+	s, _ := testServer(t)
+	defer s.Close()
+
+	code, _, stderr := testRun(t, s, "", "recordsets", "create", "ok.", "mail", "MX", "300", "10 mx1", "20 mx2.ok.")
+	if code != exitOK {
+		t.Fatalf("Expected exit code 0; got %d: %s", code, stderr)
+	}
+
+	code, stdout, stderr := testRun(t, s, "", "--output", "yaml", "recordsets", "list", "ok.", "--filter", "mail")
+	if code != exitOK {
+		t.Fatalf("Expected exit code 0; got %d: %s", code, stderr)
+	}
+	for _, want := range []string{"- account: system\n", "  name: mail\n", "  type: MX\n", "    - exchange: mx1.ok.\n      preference: 10\n"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("Expected YAML to contain %q; got:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestZonesImportAndExport(t *testing.T) {
+	s, _ := testServer(t)
+	defer s.Close()
+
+	code, stdout, stderr := testRun(t, s, "$TTL 300\napi A 10.0.0.2\n", "zones", "import", "ok.", "-")
+	if code != exitOK || !strings.Contains(stdout, "created") {
+		t.Fatalf("Expected the import to create api A; got %d: %s%s", code, stdout, stderr)
+	}
+
+	code, stdout, _ = testRun(t, s, "", "zones", "export", "ok.")
+	if code != exitOK || !strings.Contains(stdout, "api\t300\tIN\tA\t10.0.0.2\n") {
+		t.Errorf("Expected the export to contain api A; got %d:\n%s", code, stdout)
+	}
+}
+
+func TestExitCodes(t *testing.T) {
+	s, _ := testServer(t)
+	defer s.Close()
+
+	if code, _, _ := testRun(t, s, "", "zones", "get", "missing.ok."); code != exitNotFound {
+		t.Errorf("Expected exit code %d for a missing zone; got %d", exitNotFound, code)
+	}
+	if code, _, _ := testRun(t, s, "", "zones", "frobnicate"); code != exitUsage {
+		t.Errorf("Expected exit code %d for an unknown command; got %d", exitUsage, code)
+	}
+	if code, _, _ := testRun(t, s, "", "zones", "get"); code != exitUsage {
+		t.Errorf("Expected exit code %d for a missing argument; got %d", exitUsage, code)
+	}
+	if code, _, _ := testRun(t, s, "", "-o", "xml", "zones", "list"); code != exitUsage {
+		t.Errorf("Expected exit code %d for an unknown output format; got %d", exitUsage, code)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "boom", http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--host", failing.URL, "status"}, nil, &stdout, &stderr); code != exitServerError {
+		t.Errorf("Expected exit code %d for a server error; got %d: %s", exitServerError, code, stderr.String())
+	}
+}
+
+func TestProfile(t *testing.T) {
+	s, zone := testServer(t)
+	defer s.Close()
+
+	config := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(config, []byte("[profile test]\nhost = "+s.URL+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("VINYLDNS_CONFIG_FILE", config)
+	t.Setenv("VINYLDNS_HOST", "")
+
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"--profile", "test", "zones", "get", zone.ID}, nil, &stdout, &stderr); code != exitOK {
+		t.Errorf("Expected exit code 0 using the test profile's host; got %d: %s", code, stderr.String())
+	}
+	if code := run([]string{"--profile", "missing", "zones", "list"}, nil, &stdout, &stderr); code != exitError {
+		t.Errorf("Expected exit code %d for a missing profile; got %d", exitError, code)
+	}
+}
+
+func TestWriteYAML(t *testing.T) {
+	value := map[string]interface{}{
+		"name":   "ok.",
+		"count":  2,
+		"shared": false,
+		"empty":  []string{},
+		"quoted": "true",
+		"rules":  []map[string]interface{}{{"accessLevel": "Read", "recordTypes": []string{"A", "AAAA"}}},
+	}
+
+	var buf bytes.Buffer
+	if err := writeYAML(&buf, &output{value: value}); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := strings.Join([]string{
+		"count: 2",
+		"empty: []",
+		"name: ok.",
+		"quoted: \"true\"",
+		"rules:",
+		"  - accessLevel: Read",
+		"    recordTypes:",
+		"      - A",
+		"      - AAAA",
+		"shared: false",
+		"",
+	}, "\n")
+	if buf.String() != expected {
+		t.Errorf("Unexpected YAML:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestCreateCommands(t *testing.T) {
+	s, _ := testServer(t)
+	defer s.Close()
+
+	if code, _, _ := testRun(t, s, "", "groups", "create", "new-group"); code != exitUsage {
+		t.Errorf("Expected exit code %d for a group without an email; got %d", exitUsage, code)
+	}
+	code, stdout, stderr := testRun(t, s, "", "-o", "json", "groups", "create", "new-group", "--email", "new@example.com", "--admins", "admin-id", "--members", "admin-id,member-id")
+	if code != exitOK {
+		t.Fatalf("Expected exit code 0; got %d: %s", code, stderr)
+	}
+	group := vinyldns.Group{}
+	if err := json.Unmarshal([]byte(stdout), &group); err != nil {
+		t.Fatal(err)
+	}
+	if group.ID == "" || len(group.Admins) != 1 || len(group.Members) != 2 {
+		t.Errorf("Expected a group of 1 admin and 2 members; got %+v", group)
+	}
+
+	code, stdout, stderr = testRun(t, s, "", "zones", "create", "new.", "--email", "new@example.com", "--admin-group", group.ID)
+	if code != exitOK || !strings.Contains(stdout, "new.") {
+		t.Fatalf("Expected the zone to be created; got %d: %s%s", code, stdout, stderr)
+	}
+
+	batch := `{"comments":"from a file","changes":[{"changeType":"Add","inputName":"api.ok.","type":"A","ttl":300,"record":{"address":"10.0.0.2"}}]}`
+	code, stdout, stderr = testRun(t, s, batch, "batch", "create", "-")
+	if code != exitOK || !strings.Contains(stdout, "from a file") {
+		t.Fatalf("Expected the batch change to be created; got %d: %s%s", code, stdout, stderr)
+	}
+	if code, _, stderr := testRun(t, s, batch, "batch", "create", "-", "--scheduled", "tomorrow"); code != exitError || !strings.Contains(stderr, "invalid --scheduled time") {
+		t.Errorf("Expected an invalid scheduled time to fail; got %d: %s", code, stderr)
+	}
+
+	code, stdout, _ = testRun(t, s, "", "recordsets", "list", "ok.", "--filter", "api")
+	if code != exitOK || !strings.Contains(stdout, "10.0.0.2") {
+		t.Errorf("Expected the batch change to create api A; got %d:\n%s", code, stdout)
+	}
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// output is the result of a command: a value, written as is in the json and
+// yaml formats, and its rows, written in the table format.
+type output struct {
+	value   interface{}
+	headers []string
+	rows    [][]string
+}
+
+// formats maps the --output format names to their writers.
+var formats = map[string]func(io.Writer, *output) error{
+	"table": writeTable,
+	"json":  writeJSON,
+	"yaml":  writeYAML,
+}
+
+func writeTable(w io.Writer, out *output) error {
+	if out.headers == nil {
+		return writeYAML(w, out)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(out.headers, "\t"))
+	for _, row := range out.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func writeJSON(w io.Writer, out *output) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(out.value)
+}
+
+// writeYAML writes the value as YAML by way of its JSON encoding,
+// so that field names and omitted fields match the json format.
+func writeYAML(w io.Writer, out *output) error {
+	b, err := json.Marshal(out.value)
+	if err != nil {
+		return err
+	}
+
+	var v interface{}
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	encodeYAML(&buf, v, 0)
+	_, err = w.Write(buf.Bytes())
+
+	return err
+}
+
+// encodeYAML writes the decoded JSON value v as a YAML block at the indent it's passed.
+func encodeYAML(b *bytes.Buffer, v interface{}, indent int) {
+	pad := strings.Repeat("  ", indent)
+
+	switch v := v.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			b.WriteString(pad + "{}\n")
+			return
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			b.WriteString(pad + yamlScalar(k) + ":")
+			encodeYAMLValue(b, v[k], indent+1)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			b.WriteString(pad + "[]\n")
+			return
+		}
+		for _, item := range v {
+			// A mapping item starts on the line of its "-", with its keys aligned.
+			if m, ok := item.(map[string]interface{}); ok && len(m) != 0 {
+				var item bytes.Buffer
+				encodeYAML(&item, m, indent+1)
+				b.WriteString(pad + "- ")
+				b.Write(item.Bytes()[len(pad)+2:])
+				continue
+			}
+			b.WriteString(pad + "-")
+			encodeYAMLValue(b, item, indent+1)
+		}
+	default:
+		b.WriteString(pad + yamlScalar(v) + "\n")
+	}
+}
+
+// encodeYAMLValue writes the value of a mapping key or sequence item whose
+// indicator has been written: scalars and empty collections on the same line,
+// other collections on the following lines.
+func encodeYAMLValue(b *bytes.Buffer, v interface{}, indent int) {
+	switch c := v.(type) {
+	case map[string]interface{}:
+		if len(c) == 0 {
+			b.WriteString(" {}\n")
+			return
+		}
+	case []interface{}:
+		if len(c) == 0 {
+			b.WriteString(" []\n")
+			return
+		}
+	default:
+		b.WriteString(" " + yamlScalar(v) + "\n")
+		return
+	}
+
+	b.WriteString("\n")
+	encodeYAML(b, v, indent)
+}
+
+// yamlScalar returns the YAML presentation of a decoded JSON scalar,
+// quoting strings that would otherwise be read as another type or
+// that contain YAML syntax.
+func yamlScalar(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return strconv.FormatBool(v)
+	case json.Number:
+		return v.String()
+	case string:
+		if yamlNeedsQuotes(v) {
+			return strconv.Quote(v)
+		}
+		return v
+	}
+
+	return fmt.Sprint(v)
+}
+
+func yamlNeedsQuotes(s string) bool {
+	if s == "" || strings.TrimSpace(s) != s {
+		return true
+	}
+	switch strings.ToLower(s) {
+	case "true", "false", "yes", "no", "on", "off", "null", "~", "y", "n":
+		return true
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		return true
+	}
+	if strings.ContainsAny(s[:1], "-?:,[]{}#&*!|>'\"%@`") {
+		return true
+	}
+	if strings.HasSuffix(s, ":") || strings.Contains(s, ": ") || strings.Contains(s, " #") {
+		return true
+	}
+	for _, c := range s {
+		if c < 0x20 || c == 0x7f {
+			return true
+		}
+	}
+
+	return false
+}
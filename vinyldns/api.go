@@ -0,0 +1,244 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"context"
+	"io"
+	"iter"
+)
+
+// ZonesAPI is the set of Client methods for zones and zone changes.
+type ZonesAPI interface {
+	Zones() ([]Zone, error)
+	ZonesWithContext(ctx context.Context) ([]Zone, error)
+	ZonesListAll(filter ListFilter) ([]Zone, error)
+	ZonesListAllWithContext(ctx context.Context, filter ListFilter) ([]Zone, error)
+	ZonesList(filter ZoneListFilter) (*Zones, error)
+	ZonesListWithContext(ctx context.Context, filter ZoneListFilter) (*Zones, error)
+	ZonesListAllFiltered(filter ZoneListFilter) ([]Zone, error)
+	ZonesListAllFilteredWithContext(ctx context.Context, filter ZoneListFilter) ([]Zone, error)
+	ZonesPager(ctx context.Context, filter ListFilter) *Pager[Zone, string]
+	ZonesIter(ctx context.Context, filter ListFilter) iter.Seq2[Zone, error]
+	ZonesPagerFiltered(ctx context.Context, filter ZoneListFilter) *Pager[Zone, string]
+	ZonesIterFiltered(ctx context.Context, filter ZoneListFilter) iter.Seq2[Zone, error]
+	Zone(id string) (Zone, error)
+	ZoneWithContext(ctx context.Context, id string) (Zone, error)
+	ZoneDetails(id string) (ZoneDetails, error)
+	ZoneDetailsWithContext(ctx context.Context, id string) (ZoneDetails, error)
+	ZoneBackendIDs() ([]string, error)
+	ZoneBackendIDsWithContext(ctx context.Context) ([]string, error)
+	ZoneByID(id string) (Zone, error)
+	ZoneByIDWithContext(ctx context.Context, id string) (Zone, error)
+	ZoneByName(name string) (Zone, error)
+	ZoneByNameWithContext(ctx context.Context, name string) (Zone, error)
+	ZonesDeleted(filter DeletedZonesFilter) (*DeletedZonesResponse, error)
+	ZonesDeletedWithContext(ctx context.Context, filter DeletedZonesFilter) (*DeletedZonesResponse, error)
+	ZoneACLRuleCreate(zoneID string, rule *ACLRule) (*ZoneUpdateResponse, error)
+	ZoneACLRuleCreateWithContext(ctx context.Context, zoneID string, rule *ACLRule) (*ZoneUpdateResponse, error)
+	ZoneACLRuleDelete(zoneID string, rule *ACLRule) (*ZoneUpdateResponse, error)
+	ZoneACLRuleDeleteWithContext(ctx context.Context, zoneID string, rule *ACLRule) (*ZoneUpdateResponse, error)
+	ZoneCreate(z *Zone) (*ZoneUpdateResponse, error)
+	ZoneCreateWithContext(ctx context.Context, z *Zone) (*ZoneUpdateResponse, error)
+	ZoneUpdate(z *Zone) (*ZoneUpdateResponse, error)
+	ZoneUpdateWithContext(ctx context.Context, z *Zone) (*ZoneUpdateResponse, error)
+	ZoneDelete(zoneID string) (*ZoneUpdateResponse, error)
+	ZoneDeleteWithContext(ctx context.Context, zoneID string) (*ZoneUpdateResponse, error)
+	ZoneExists(id string) (bool, error)
+	ZoneExistsWithContext(ctx context.Context, id string) (bool, error)
+	ZoneNameExists(name string) (bool, error)
+	ZoneNameExistsWithContext(ctx context.Context, name string) (bool, error)
+	ZoneChanges(id string) (*ZoneChanges, error)
+	ZoneChangesWithContext(ctx context.Context, id string) (*ZoneChanges, error)
+	ZoneChangesFailure(filter ListFilter) (*ZoneChangeFailuresResponse, error)
+	ZoneChangesFailureWithContext(ctx context.Context, filter ListFilter) (*ZoneChangeFailuresResponse, error)
+	ZoneChangesListAll(zoneID string, filter ListFilter) ([]ZoneChange, error)
+	ZoneChangesListAllWithContext(ctx context.Context, zoneID string, filter ListFilter) ([]ZoneChange, error)
+	ZoneChangesPager(ctx context.Context, zoneID string, filter ListFilter) *Pager[ZoneChange, string]
+	ZoneChangesIter(ctx context.Context, zoneID string, filter ListFilter) iter.Seq2[ZoneChange, error]
+	ZoneChange(zoneID, zoneChangeID string) (ZoneChange, error)
+	ZoneChangeWithContext(ctx context.Context, zoneID, zoneChangeID string) (ZoneChange, error)
+	ZoneSync(zoneId string) (ZoneChange, error)
+	ZoneSyncWithContext(ctx context.Context, zoneId string) (ZoneChange, error)
+	ZoneFileExport(zoneID string, w io.Writer) error
+	ZoneFileExportWithContext(ctx context.Context, zoneID string, w io.Writer) error
+	ZoneFileImport(zoneID string, r io.Reader, opts ZoneFileParseOptions) (*RecordSetsImportResult, error)
+	ZoneFileImportWithContext(ctx context.Context, zoneID string, r io.Reader, opts ZoneFileParseOptions) (*RecordSetsImportResult, error)
+	ZoneSnapshot(zoneID string) (*Snapshot, error)
+	ZoneSnapshotWithContext(ctx context.Context, zoneID string) (*Snapshot, error)
+	ZoneSnapshotDiff(before *Snapshot) (*SnapshotDiff, error)
+	ZoneSnapshotDiffWithContext(ctx context.Context, before *Snapshot) (*SnapshotDiff, error)
+	WaitForZoneChange(ctx context.Context, zoneID, zoneChangeID string, opts *WaitOptions) (*ZoneChange, error)
+}
+
+// RecordSetsAPI is the set of Client methods for record sets and record set changes.
+type RecordSetsAPI interface {
+	RecordSetCollector(zoneID string, limit int) (func() ([]RecordSet, error), error)
+	RecordSetCollectorWithContext(ctx context.Context, zoneID string, limit int) (func() ([]RecordSet, error), error)
+	RecordSetCollectorFiltered(zoneID string, filter ZoneRecordSetFilter) (func() ([]RecordSet, error), error)
+	RecordSetCollectorFilteredWithContext(ctx context.Context, zoneID string, filter ZoneRecordSetFilter) (func() ([]RecordSet, error), error)
+	RecordSets(id string) ([]RecordSet, error)
+	RecordSetsWithContext(ctx context.Context, id string) ([]RecordSet, error)
+	RecordSetsListAll(zoneID string, filter ListFilter) ([]RecordSet, error)
+	RecordSetsListAllWithContext(ctx context.Context, zoneID string, filter ListFilter) ([]RecordSet, error)
+	RecordSetsListAllFiltered(zoneID string, filter ZoneRecordSetFilter) ([]RecordSet, error)
+	RecordSetsListAllFilteredWithContext(ctx context.Context, zoneID string, filter ZoneRecordSetFilter) ([]RecordSet, error)
+	RecordSetsPager(ctx context.Context, zoneID string, filter ListFilter) *Pager[RecordSet, string]
+	RecordSetsIter(ctx context.Context, zoneID string, filter ListFilter) iter.Seq2[RecordSet, error]
+	RecordSetsPagerFiltered(ctx context.Context, zoneID string, filter ZoneRecordSetFilter) *Pager[RecordSet, string]
+	RecordSetsIterFiltered(ctx context.Context, zoneID string, filter ZoneRecordSetFilter) iter.Seq2[RecordSet, error]
+	RecordSetsGlobal(filter GlobalListFilter) ([]RecordSet, string, error)
+	RecordSetsGlobalWithContext(ctx context.Context, filter GlobalListFilter) ([]RecordSet, string, error)
+	RecordSetsGlobalListAll(filter GlobalListFilter) ([]RecordSet, error)
+	RecordSetsGlobalListAllWithContext(ctx context.Context, filter GlobalListFilter) ([]RecordSet, error)
+	RecordSetsGlobalPager(ctx context.Context, filter GlobalListFilter) *Pager[RecordSet, string]
+	RecordSetsGlobalIter(ctx context.Context, filter GlobalListFilter) iter.Seq2[RecordSet, error]
+	RecordSet(zoneID, recordSetID string) (RecordSet, error)
+	RecordSetWithContext(ctx context.Context, zoneID, recordSetID string) (RecordSet, error)
+	RecordSetCount(zoneID string) (RecordSetCount, error)
+	RecordSetCountWithContext(ctx context.Context, zoneID string) (RecordSetCount, error)
+	RecordSetCreate(rs *RecordSet) (*RecordSetUpdateResponse, error)
+	RecordSetCreateWithContext(ctx context.Context, rs *RecordSet) (*RecordSetUpdateResponse, error)
+	RecordSetUpdate(rs *RecordSet) (*RecordSetUpdateResponse, error)
+	RecordSetUpdateWithContext(ctx context.Context, rs *RecordSet) (*RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferRequest(rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferRequestWithContext(ctx context.Context, rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferApprove(rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferApproveWithContext(ctx context.Context, rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferReject(rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferRejectWithContext(ctx context.Context, rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferCancel(rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferCancelWithContext(ctx context.Context, rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error)
+	RecordSetDelete(zoneID, recordSetID string) (*RecordSetUpdateResponse, error)
+	RecordSetDeleteWithContext(ctx context.Context, zoneID, recordSetID string) (*RecordSetUpdateResponse, error)
+	RecordSetChanges(zoneID string, f ListFilterRecordSetChanges) (*RecordSetChanges, error)
+	RecordSetChangesWithContext(ctx context.Context, zoneID string, f ListFilterRecordSetChanges) (*RecordSetChanges, error)
+	RecordSetChangeHistory(f RecordSetChangeHistoryFilter) (*RecordSetChanges, error)
+	RecordSetChangeHistoryWithContext(ctx context.Context, f RecordSetChangeHistoryFilter) (*RecordSetChanges, error)
+	RecordSetChangesListAll(zoneID string, filter ListFilterRecordSetChanges) ([]RecordSetChange, error)
+	RecordSetChangesListAllWithContext(ctx context.Context, zoneID string, filter ListFilterRecordSetChanges) ([]RecordSetChange, error)
+	RecordSetChangesPager(ctx context.Context, zoneID string, filter ListFilterRecordSetChanges) *Pager[RecordSetChange, int]
+	RecordSetChangesIter(ctx context.Context, zoneID string, filter ListFilterRecordSetChanges) iter.Seq2[RecordSetChange, error]
+	RecordSetChange(zoneID, recordSetID, changeID string) (*RecordSetChange, error)
+	RecordSetChangeWithContext(ctx context.Context, zoneID, recordSetID, changeID string) (*RecordSetChange, error)
+	RecordSetChangesFailure(zoneID string, filter ListFilter) (*RecordSetChangeFailuresResponse, error)
+	RecordSetChangesFailureWithContext(ctx context.Context, zoneID string, filter ListFilter) (*RecordSetChangeFailuresResponse, error)
+	RecordSetsImport(zoneID string, recordSets []RecordSet) (*RecordSetsImportResult, error)
+	RecordSetsImportWithContext(ctx context.Context, zoneID string, recordSets []RecordSet) (*RecordSetsImportResult, error)
+	RecordSetsPlan(zoneID string, desired []RecordSet, opts PlanOptions) (*Plan, error)
+	RecordSetsPlanWithContext(ctx context.Context, zoneID string, desired []RecordSet, opts PlanOptions) (*Plan, error)
+	RecordSetsApply(plan *Plan, opts *WaitOptions) ([]RecordSetUpdateResponse, error)
+	RecordSetsApplyWithContext(ctx context.Context, plan *Plan, opts *WaitOptions) ([]RecordSetUpdateResponse, error)
+	WaitForRecordSetChange(ctx context.Context, zoneID, recordSetID, changeID string, opts *WaitOptions) (*RecordSetChange, error)
+}
+
+// GroupsAPI is the set of Client methods for groups and group changes.
+type GroupsAPI interface {
+	Groups() ([]Group, error)
+	GroupsWithContext(ctx context.Context) ([]Group, error)
+	GroupsListAll(filter ListFilter) ([]Group, error)
+	GroupsListAllWithContext(ctx context.Context, filter ListFilter) ([]Group, error)
+	GroupsPager(ctx context.Context, filter ListFilter) *Pager[Group, string]
+	GroupsIter(ctx context.Context, filter ListFilter) iter.Seq2[Group, error]
+	GroupCreate(g *Group) (*Group, error)
+	GroupCreateWithContext(ctx context.Context, g *Group) (*Group, error)
+	Group(groupID string) (*Group, error)
+	GroupWithContext(ctx context.Context, groupID string) (*Group, error)
+	GroupDelete(groupID string) (*Group, error)
+	GroupDeleteWithContext(ctx context.Context, groupID string) (*Group, error)
+	GroupUpdate(groupID string, g *Group) (*Group, error)
+	GroupUpdateWithContext(ctx context.Context, groupID string, g *Group) (*Group, error)
+	GroupAdmins(groupID string) ([]User, error)
+	GroupAdminsWithContext(ctx context.Context, groupID string) ([]User, error)
+	GroupMembers(groupID string) ([]User, error)
+	GroupMembersWithContext(ctx context.Context, groupID string) ([]User, error)
+	GroupActivity(groupID string) (*GroupChanges, error)
+	GroupActivityWithContext(ctx context.Context, groupID string) (*GroupChanges, error)
+	GroupChange(groupChangeID string) (*GroupChange, error)
+	GroupChangeWithContext(ctx context.Context, groupChangeID string) (*GroupChange, error)
+	GroupValidDomains() ([]string, error)
+	GroupValidDomainsWithContext(ctx context.Context) ([]string, error)
+}
+
+// BatchChangesAPI is the set of Client methods for batch record changes.
+type BatchChangesAPI interface {
+	BatchRecordChanges() ([]RecordChange, error)
+	BatchRecordChangesWithContext(ctx context.Context) ([]RecordChange, error)
+	BatchRecordChangesList(filter BatchRecordChangesFilter) (*BatchChangeSummaries, error)
+	BatchRecordChangesListWithContext(ctx context.Context, filter BatchRecordChangesFilter) (*BatchChangeSummaries, error)
+	BatchRecordChangesListAll(filter BatchRecordChangesFilter) ([]BatchChangeSummary, error)
+	BatchRecordChangesListAllWithContext(ctx context.Context, filter BatchRecordChangesFilter) ([]BatchChangeSummary, error)
+	BatchRecordChangesPager(ctx context.Context, filter BatchRecordChangesFilter) *Pager[BatchChangeSummary, int]
+	BatchRecordChangesIter(ctx context.Context, filter BatchRecordChangesFilter) iter.Seq2[BatchChangeSummary, error]
+	BatchRecordChangesScheduled(filter BatchRecordChangesFilter) ([]BatchChangeSummary, error)
+	BatchRecordChangesScheduledWithContext(ctx context.Context, filter BatchRecordChangesFilter) ([]BatchChangeSummary, error)
+	BatchRecordChange(changeID string) (*BatchRecordChange, error)
+	BatchRecordChangeWithContext(ctx context.Context, changeID string) (*BatchRecordChange, error)
+	BatchRecordChangeCreate(change *BatchRecordChange) (*BatchRecordChangeUpdateResponse, error)
+	BatchRecordChangeCreateWithContext(ctx context.Context, change *BatchRecordChange) (*BatchRecordChangeUpdateResponse, error)
+	BatchRecordChangeSubmit(change *BatchRecordChange, opts *BatchChangeCreateOptions) (*BatchRecordChangeUpdateResponse, error)
+	BatchRecordChangeSubmitWithContext(ctx context.Context, change *BatchRecordChange, opts *BatchChangeCreateOptions) (*BatchRecordChangeUpdateResponse, error)
+	BatchRecordChangeCreateChunked(change *BatchRecordChange, opts *ChunkOptions) (*ChunkedBatchChangeResult, error)
+	BatchRecordChangeCreateChunkedWithContext(ctx context.Context, change *BatchRecordChange, opts *ChunkOptions) (*ChunkedBatchChangeResult, error)
+	BatchRecordChangeApprove(changeID string, review *BatchChangeReview) (*BatchRecordChange, error)
+	BatchRecordChangeApproveWithContext(ctx context.Context, changeID string, review *BatchChangeReview) (*BatchRecordChange, error)
+	BatchRecordChangeReject(changeID string, review *BatchChangeReview) (*BatchRecordChange, error)
+	BatchRecordChangeRejectWithContext(ctx context.Context, changeID string, review *BatchChangeReview) (*BatchRecordChange, error)
+	BatchRecordChangeCancel(changeID string, review *BatchChangeReview) (*BatchRecordChange, error)
+	BatchRecordChangeCancelWithContext(ctx context.Context, changeID string, review *BatchChangeReview) (*BatchRecordChange, error)
+	BatchRecordChangeRollbackPlan(changeID string) (*BatchRollbackPlan, error)
+	BatchRecordChangeRollbackPlanWithContext(ctx context.Context, changeID string) (*BatchRollbackPlan, error)
+	BatchRecordChangeRollback(plan *BatchRollbackPlan) (*BatchRecordChangeUpdateResponse, error)
+	BatchRecordChangeRollbackWithContext(ctx context.Context, plan *BatchRollbackPlan) (*BatchRecordChangeUpdateResponse, error)
+	WaitForBatchChange(ctx context.Context, changeID string, opts *WaitOptions) (*BatchRecordChange, error)
+}
+
+// UsersAPI is the set of Client methods for users.
+type UsersAPI interface {
+	User(userIdentifier string) (UserInfo, error)
+	UserWithContext(ctx context.Context, userIdentifier string) (UserInfo, error)
+	UserLock(userID string) (UserInfo, error)
+	UserLockWithContext(ctx context.Context, userID string) (UserInfo, error)
+	UserUnlock(userID string) (UserInfo, error)
+	UserUnlockWithContext(ctx context.Context, userID string) (UserInfo, error)
+}
+
+// SystemAPI is the set of Client methods for VinylDNS status, health and metrics.
+type SystemAPI interface {
+	Status() (SystemStatus, error)
+	StatusWithContext(ctx context.Context) (SystemStatus, error)
+	StatusUpdate(processingDisabled bool) (SystemStatus, error)
+	StatusUpdateWithContext(ctx context.Context, processingDisabled bool) (SystemStatus, error)
+	Ping() (string, error)
+	PingWithContext(ctx context.Context) (string, error)
+	Health() error
+	HealthWithContext(ctx context.Context) error
+	Color() (string, error)
+	ColorWithContext(ctx context.Context) (string, error)
+	MetricsPrometheus(names []string) (string, error)
+	MetricsPrometheusWithContext(ctx context.Context, names []string) (string, error)
+}
+
+// API is the full set of VinylDNS API methods implemented by Client.
+// Code that depends on API, or on one of the narrower per-resource
+// interfaces, can be tested with a mock such as vinyldnstest.Mock.
+type API interface {
+	ZonesAPI
+	RecordSetsAPI
+	GroupsAPI
+	BatchChangesAPI
+	UsersAPI
+	SystemAPI
+}
+
+var _ API = (*Client)(nil)
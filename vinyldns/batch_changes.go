@@ -12,12 +12,28 @@ limitations under the License.
 
 package vinyldns
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"net/http"
+	"strings"
+	"time"
+)
 
-// BatchRecordChanges returns the list of batch record changes
+// BatchRecordChanges returns the first page of the list of batch record changes.
+// See BatchRecordChangesListAll to retrieve every batch change.
 func (c *Client) BatchRecordChanges() ([]RecordChange, error) {
+	return c.BatchRecordChangesWithContext(context.Background())
+}
+
+// BatchRecordChangesWithContext returns the list of batch record changes,
+// using ctx for the lifetime of the request.
+func (c *Client) BatchRecordChangesWithContext(ctx context.Context) ([]RecordChange, error) {
 	changes := &BatchRecordChanges{}
-	err := resourceRequest(c, batchRecordChangesEP(c), "GET", nil, changes)
+	err := resourceRequest(ctx, c, batchRecordChangesEP(c), "GET", nil, changes)
 	if err != nil {
 		return nil, err
 	}
@@ -25,11 +41,128 @@ func (c *Client) BatchRecordChanges() ([]RecordChange, error) {
 	return changes.BatchChanges, nil
 }
 
+// BatchRecordChangesList retrieves a page of the batch changes
+// matching the BatchRecordChangesFilter criteria passed.
+func (c *Client) BatchRecordChangesList(filter BatchRecordChangesFilter) (*BatchChangeSummaries, error) {
+	return c.BatchRecordChangesListWithContext(context.Background(), filter)
+}
+
+// BatchRecordChangesListWithContext retrieves a page of the batch changes matching
+// the BatchRecordChangesFilter criteria passed, using ctx for the lifetime of the request.
+func (c *Client) BatchRecordChangesListWithContext(ctx context.Context, filter BatchRecordChangesFilter) (*BatchChangeSummaries, error) {
+	if filter.MaxItems > 100 {
+		return nil, fmt.Errorf("MaxItems must be between 1 and 100")
+	}
+
+	summaries := &BatchChangeSummaries{}
+	err := resourceRequest(ctx, c, batchRecordChangesListEP(c, filter), "GET", nil, summaries)
+	if err != nil {
+		return nil, err
+	}
+
+	return summaries, nil
+}
+
+// BatchRecordChangesListAll retrieves the complete list of batch changes with the
+// BatchRecordChangesFilter criteria passed, such as every batch change pending review.
+// Handles paging through results on the user's behalf.
+func (c *Client) BatchRecordChangesListAll(filter BatchRecordChangesFilter) ([]BatchChangeSummary, error) {
+	return c.BatchRecordChangesListAllWithContext(context.Background(), filter)
+}
+
+// BatchRecordChangesListAllWithContext is BatchRecordChangesListAll with a
+// context.Context that is applied to each page request.
+func (c *Client) BatchRecordChangesListAllWithContext(ctx context.Context, filter BatchRecordChangesFilter) ([]BatchChangeSummary, error) {
+	summaries := []BatchChangeSummary{}
+
+	for {
+		resp, err := c.BatchRecordChangesListWithContext(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, resp.BatchChanges...)
+		filter.StartFrom = resp.NextID
+
+		if filter.StartFrom == 0 {
+			return summaries, nil
+		}
+	}
+}
+
+// BatchRecordChangesPager returns a Pager over the batch changes matching the
+// BatchRecordChangesFilter criteria passed, fetching pages lazily starting at filter.StartFrom.
+func (c *Client) BatchRecordChangesPager(ctx context.Context, filter BatchRecordChangesFilter) *Pager[BatchChangeSummary, int] {
+	return NewPager(ctx, filter.StartFrom, func(ctx context.Context, startFrom int) ([]BatchChangeSummary, int, error) {
+		filter.StartFrom = startFrom
+		resp, err := c.BatchRecordChangesListWithContext(ctx, filter)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.BatchChanges, resp.NextID, nil
+	})
+}
+
+// BatchRecordChangesIter returns an iterator over the batch changes
+// matching the BatchRecordChangesFilter criteria passed.
+func (c *Client) BatchRecordChangesIter(ctx context.Context, filter BatchRecordChangesFilter) iter.Seq2[BatchChangeSummary, error] {
+	return c.BatchRecordChangesPager(ctx, filter).All()
+}
+
+// BatchRecordChangesScheduled returns the batch changes matching filter that
+// are scheduled and have yet to be applied. Scheduled batch changes are applied
+// once approved at or after their scheduled time; see BatchChangeSummary.ScheduledAt.
+func (c *Client) BatchRecordChangesScheduled(filter BatchRecordChangesFilter) ([]BatchChangeSummary, error) {
+	return c.BatchRecordChangesScheduledWithContext(context.Background(), filter)
+}
+
+// BatchRecordChangesScheduledWithContext returns the batch changes matching filter
+// that are scheduled and have yet to be applied, using ctx for the lifetime of the requests.
+//
+// As scheduled batch changes await review, only those pending review are listed,
+// narrowing the listing on the server. filter.DateTimeRangeStart and
+// filter.DateTimeRangeEnd bound their creation times on the server, while
+// filter.ScheduledTimeStart and filter.ScheduledTimeEnd bound their scheduled
+// times as they're listed.
+func (c *Client) BatchRecordChangesScheduledWithContext(ctx context.Context, filter BatchRecordChangesFilter) ([]BatchChangeSummary, error) {
+	scheduled := []BatchChangeSummary{}
+	if filter.ApprovalStatus != "" && filter.ApprovalStatus != ApprovalStatusPendingReview {
+		return scheduled, nil
+	}
+	filter.ApprovalStatus = ApprovalStatusPendingReview
+
+	for summary, err := range c.BatchRecordChangesIter(ctx, filter) {
+		if err != nil {
+			return nil, err
+		}
+		if summary.Status != BatchChangeStatusScheduled {
+			continue
+		}
+		at, ok := summary.ScheduledAt()
+		switch {
+		case !ok && (!filter.ScheduledTimeStart.IsZero() || !filter.ScheduledTimeEnd.IsZero()),
+			!filter.ScheduledTimeStart.IsZero() && at.Before(filter.ScheduledTimeStart),
+			!filter.ScheduledTimeEnd.IsZero() && at.After(filter.ScheduledTimeEnd):
+			continue
+		}
+		scheduled = append(scheduled, summary)
+	}
+
+	return scheduled, nil
+}
+
 // BatchRecordChange returns the batch record change
 // associated with the change whose ID it's passed.
 func (c *Client) BatchRecordChange(changeID string) (*BatchRecordChange, error) {
+	return c.BatchRecordChangeWithContext(context.Background(), changeID)
+}
+
+// BatchRecordChangeWithContext returns the batch record change
+// associated with the change whose ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) BatchRecordChangeWithContext(ctx context.Context, changeID string) (*BatchRecordChange, error) {
 	change := &BatchRecordChange{}
-	err := resourceRequest(c, batchRecordChangeEP(c, changeID), "GET", nil, change)
+	err := resourceRequest(ctx, c, batchRecordChangeEP(c, changeID), "GET", nil, change)
 	if err != nil {
 		return nil, err
 	}
@@ -39,35 +172,153 @@ func (c *Client) BatchRecordChange(changeID string) (*BatchRecordChange, error)
 
 // BatchRecordChangeCreate creates the batch record change it's passed.
 func (c *Client) BatchRecordChangeCreate(change *BatchRecordChange) (*BatchRecordChangeUpdateResponse, error) {
-	cJSON, err := json.Marshal(change)
+	return c.BatchRecordChangeCreateWithContext(context.Background(), change)
+}
+
+// BatchRecordChangeCreateWithContext creates the batch record change it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) BatchRecordChangeCreateWithContext(ctx context.Context, change *BatchRecordChange) (*BatchRecordChangeUpdateResponse, error) {
+	return c.BatchRecordChangeSubmitWithContext(ctx, change, nil)
+}
+
+// BatchRecordChangeSubmit creates the batch record change it's passed,
+// configured by opts, which may be nil.
+func (c *Client) BatchRecordChangeSubmit(change *BatchRecordChange, opts *BatchChangeCreateOptions) (*BatchRecordChangeUpdateResponse, error) {
+	return c.BatchRecordChangeSubmitWithContext(context.Background(), change, opts)
+}
+
+// BatchRecordChangeSubmitWithContext creates the batch record change it's passed,
+// configured by opts, which may be nil, using ctx for the lifetime of the request.
+// Scheduling a change on a server that doesn't allow it, or for a time that isn't
+// in the future, returns an error matching ErrScheduledChangesDisabled or
+// ErrScheduledTimeInPast, respectively.
+func (c *Client) BatchRecordChangeSubmitWithContext(ctx context.Context, change *BatchRecordChange, opts *BatchChangeCreateOptions) (*BatchRecordChangeUpdateResponse, error) {
+	if opts == nil {
+		opts = &BatchChangeCreateOptions{}
+	}
+	body := *change
+	if !opts.ScheduledTime.IsZero() {
+		body.ScheduledTime = opts.ScheduledTime.UTC().Format(time.RFC3339)
+	}
+
+	cJSON, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	var resource = &BatchRecordChangeUpdateResponse{}
-	err = resourceRequest(c, batchRecordChangesEP(c), "POST", cJSON, resource)
+	err = resourceRequest(ctx, c, batchRecordChangesCreateEP(c, *opts), "POST", cJSON, resource)
 	if err != nil {
-		return &BatchRecordChangeUpdateResponse{}, err
+		return &BatchRecordChangeUpdateResponse{}, batchChangeValidationError(change, err)
 	}
 
 	return resource, nil
 }
 
+// BatchChangeInputError is a single change of a batch change
+// rejected by VinylDNS, along with the reasons it is invalid.
+type BatchChangeInputError struct {
+	// Index is the index of the change in the submitted batch change's Changes.
+	Index  int
+	Change RecordChange
+	Errors []string
+}
+
+// BatchChangeValidationError is returned by BatchRecordChangeCreate when VinylDNS
+// rejects a batch change as invalid, mapping each submitted change to the reasons,
+// if any, it is invalid. It wraps the *Error of the 400 response.
+type BatchChangeValidationError struct {
+	Err     *Error
+	Changes []BatchChangeInputError
+}
+
+func (e *BatchChangeValidationError) Error() string {
+	invalid := e.Invalid()
+	msgs := make([]string, len(invalid))
+	for i, c := range invalid {
+		msgs[i] = fmt.Sprintf("change %d (%s %s %s): %s", c.Index, c.Change.ChangeType, c.Change.InputName, c.Change.Type, strings.Join(c.Errors, "; "))
+	}
+
+	return fmt.Sprintf("invalid batch change: %d of %d changes rejected: %s", len(invalid), len(e.Changes), strings.Join(msgs, "; "))
+}
+
+func (e *BatchChangeValidationError) Unwrap() error {
+	return e.Err
+}
+
+// Invalid returns the changes that have errors.
+func (e *BatchChangeValidationError) Invalid() []BatchChangeInputError {
+	invalid := []BatchChangeInputError{}
+	for _, c := range e.Changes {
+		if len(c.Errors) != 0 {
+			invalid = append(invalid, c)
+		}
+	}
+
+	return invalid
+}
+
+// batchChangeValidationError returns err as a *BatchChangeValidationError
+// if it's a 400 response listing the errors of each of change's changes,
+// as VinylDNS returns for invalid batch changes, or err otherwise.
+func batchChangeValidationError(change *BatchRecordChange, err error) error {
+	var vErr *Error
+	if !errors.As(err, &vErr) || vErr.ResponseCode != http.StatusBadRequest {
+		return err
+	}
+
+	inputs := []struct {
+		RecordChange
+		Errors []string `json:"errors"`
+	}{}
+	if json.Unmarshal([]byte(vErr.ResponseBody), &inputs) != nil || len(inputs) == 0 {
+		return err
+	}
+
+	changes := make([]BatchChangeInputError, len(inputs))
+	for i, input := range inputs {
+		changes[i] = BatchChangeInputError{Index: i, Change: input.RecordChange, Errors: input.Errors}
+		if len(inputs) == len(change.Changes) {
+			changes[i].Change = change.Changes[i]
+		}
+	}
+
+	return &BatchChangeValidationError{Err: vErr, Changes: changes}
+}
+
 // BatchRecordChangeApprove approves a batch record change in manual review.
 func (c *Client) BatchRecordChangeApprove(changeID string, review *BatchChangeReview) (*BatchRecordChange, error) {
-	return c.batchRecordChangeReviewAction(changeID, review, batchRecordChangeApproveEP)
+	return c.BatchRecordChangeApproveWithContext(context.Background(), changeID, review)
+}
+
+// BatchRecordChangeApproveWithContext approves a batch record change in manual review,
+// using ctx for the lifetime of the request.
+func (c *Client) BatchRecordChangeApproveWithContext(ctx context.Context, changeID string, review *BatchChangeReview) (*BatchRecordChange, error) {
+	return c.batchRecordChangeReviewAction(ctx, changeID, review, batchRecordChangeApproveEP)
 }
 
 // BatchRecordChangeReject rejects a batch record change in manual review.
 func (c *Client) BatchRecordChangeReject(changeID string, review *BatchChangeReview) (*BatchRecordChange, error) {
-	return c.batchRecordChangeReviewAction(changeID, review, batchRecordChangeRejectEP)
+	return c.BatchRecordChangeRejectWithContext(context.Background(), changeID, review)
+}
+
+// BatchRecordChangeRejectWithContext rejects a batch record change in manual review,
+// using ctx for the lifetime of the request.
+func (c *Client) BatchRecordChangeRejectWithContext(ctx context.Context, changeID string, review *BatchChangeReview) (*BatchRecordChange, error) {
+	return c.batchRecordChangeReviewAction(ctx, changeID, review, batchRecordChangeRejectEP)
 }
 
 // BatchRecordChangeCancel cancels a batch record change.
 func (c *Client) BatchRecordChangeCancel(changeID string, review *BatchChangeReview) (*BatchRecordChange, error) {
-	return c.batchRecordChangeReviewAction(changeID, review, batchRecordChangeCancelEP)
+	return c.BatchRecordChangeCancelWithContext(context.Background(), changeID, review)
+}
+
+// BatchRecordChangeCancelWithContext cancels a batch record change,
+// using ctx for the lifetime of the request.
+func (c *Client) BatchRecordChangeCancelWithContext(ctx context.Context, changeID string, review *BatchChangeReview) (*BatchRecordChange, error) {
+	return c.batchRecordChangeReviewAction(ctx, changeID, review, batchRecordChangeCancelEP)
 }
 
-func (c *Client) batchRecordChangeReviewAction(changeID string, review *BatchChangeReview, endpoint func(*Client, string) string) (*BatchRecordChange, error) {
+func (c *Client) batchRecordChangeReviewAction(ctx context.Context, changeID string, review *BatchChangeReview, endpoint func(*Client, string) string) (*BatchRecordChange, error) {
 	var reviewJSON []byte
 	var err error
 	if review != nil {
@@ -77,7 +328,7 @@ func (c *Client) batchRecordChangeReviewAction(changeID string, review *BatchCha
 		}
 	}
 	resource := &BatchRecordChange{}
-	err = resourceRequest(c, endpoint(c, changeID), "POST", reviewJSON, resource)
+	err = resourceRequest(ctx, c, endpoint(c, changeID), "POST", reviewJSON, resource)
 	if err != nil {
 		return &BatchRecordChange{}, err
 	}
@@ -0,0 +1,341 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net"
+	"sort"
+	"strings"
+)
+
+// The TTLs accepted by VinylDNS.
+const (
+	MinTTL = 30
+	MaxTTL = math.MaxInt32
+)
+
+// BatchChangeProblem is a reason a single change of a batch change is invalid.
+type BatchChangeProblem struct {
+	// Index is the index of the change in the batch change's
+	// Changes, or -1 for a problem with the batch change as a whole.
+	Index  int
+	Change RecordChange
+	Reason string
+}
+
+func (p BatchChangeProblem) String() string {
+	if p.Index < 0 {
+		return p.Reason
+	}
+
+	return fmt.Sprintf("change %d (%s %s %s): %s", p.Index, p.Change.ChangeType, p.Change.InputName, p.Change.Type, p.Reason)
+}
+
+// BatchChangeBuildError is returned by BatchChangeBuilder.Build
+// for an invalid batch change, listing every problem found.
+type BatchChangeBuildError struct {
+	Change   *BatchRecordChange
+	Problems []BatchChangeProblem
+}
+
+func (e *BatchChangeBuildError) Error() string {
+	problems := make([]string, len(e.Problems))
+	for i, p := range e.Problems {
+		problems[i] = p.String()
+	}
+
+	return fmt.Sprintf("invalid batch change: %s", strings.Join(problems, "; "))
+}
+
+// BatchChangeBuilder builds a BatchRecordChange, validating it before
+// submission so that every problem the server would reject the batch change
+// for one at a time, and some it would only fail to apply, is reported at once.
+//
+//	change, err := vinyldns.NewBatchChangeBuilder().
+//		Comments("migrate www").
+//		DeleteRecordSet("www.ok.", vinyldns.RecordTypeCNAME).
+//		AddRecordSet("www.ok.", vinyldns.RecordTypeA, 300, vinyldns.NewARecord("10.0.0.1")).
+//		Build()
+type BatchChangeBuilder struct {
+	change BatchRecordChange
+}
+
+// NewBatchChangeBuilder returns an empty BatchChangeBuilder.
+func NewBatchChangeBuilder() *BatchChangeBuilder {
+	return &BatchChangeBuilder{}
+}
+
+// Comments sets the batch change's comments.
+func (b *BatchChangeBuilder) Comments(comments string) *BatchChangeBuilder {
+	b.change.Comments = comments
+	return b
+}
+
+// OwnerGroupID sets the ID of the group that owns the record sets the batch change creates.
+func (b *BatchChangeBuilder) OwnerGroupID(groupID string) *BatchChangeBuilder {
+	b.change.OwnerGroupID = groupID
+	return b
+}
+
+// AddRecordSet adds the records to the record set of the input name and type,
+// creating it with the TTL if it doesn't exist. A TTL of 0 is the server's default.
+// The input name of a PTR record is its IP address.
+func (b *BatchChangeBuilder) AddRecordSet(inputName string, recordType RecordType, ttl int, records ...Record) *BatchChangeBuilder {
+	change := RecordChange{ChangeType: ChangeTypeAdd, InputName: inputName, Type: string(recordType), TTL: ttl}
+	if len(records) == 0 {
+		// Recorded without data, so that Build reports the missing records.
+		b.change.Changes = append(b.change.Changes, change)
+	}
+	for _, r := range records {
+		change.Record = NewRecordData(r)
+		b.change.Changes = append(b.change.Changes, change)
+	}
+
+	return b
+}
+
+// DeleteRecordSetEntry deletes the single record of the record set
+// of the input name and type, leaving the set's other records.
+func (b *BatchChangeBuilder) DeleteRecordSetEntry(inputName string, recordType RecordType, record Record) *BatchChangeBuilder {
+	b.change.Changes = append(b.change.Changes, RecordChange{
+		ChangeType: ChangeTypeDeleteRecordSet,
+		InputName:  inputName,
+		Type:       string(recordType),
+		Record:     NewRecordData(record),
+	})
+
+	return b
+}
+
+// DeleteRecordSet deletes the whole record set of the input name and type.
+func (b *BatchChangeBuilder) DeleteRecordSet(inputName string, recordType RecordType) *BatchChangeBuilder {
+	b.change.Changes = append(b.change.Changes, RecordChange{
+		ChangeType: ChangeTypeDeleteRecordSet,
+		InputName:  inputName,
+		Type:       string(recordType),
+	})
+
+	return b
+}
+
+// Build returns the batch change, or, if it's invalid, a *BatchChangeBuildError
+// listing every problem with it: malformed input names, out of range TTLs,
+// missing or malformed record data, duplicate changes, and CNAMEs added
+// alongside records of other types.
+func (b *BatchChangeBuilder) Build() (*BatchRecordChange, error) {
+	change := b.change
+	change.Changes = append([]RecordChange{}, b.change.Changes...)
+
+	problems := []BatchChangeProblem{}
+	if len(change.Changes) == 0 {
+		problems = append(problems, BatchChangeProblem{Index: -1, Reason: "a batch change requires at least one change"})
+	}
+	for i, rc := range change.Changes {
+		for _, reason := range validateRecordChange(rc) {
+			problems = append(problems, BatchChangeProblem{Index: i, Change: rc, Reason: reason})
+		}
+	}
+	problems = append(problems, batchChangeConflicts(change.Changes)...)
+	sort.SliceStable(problems, func(i, j int) bool {
+		return problems[i].Index < problems[j].Index
+	})
+
+	if len(problems) != 0 {
+		return nil, &BatchChangeBuildError{Change: &change, Problems: problems}
+	}
+
+	return &change, nil
+}
+
+// validateRecordChange returns the reasons the single change rc is invalid, if any.
+func validateRecordChange(rc RecordChange) []string {
+	reasons := []string{}
+
+	recordType := RecordType(rc.Type)
+	if !isBatchRecordType(recordType) {
+		return append(reasons, fmt.Sprintf("batch changes don't support %q records", rc.Type))
+	}
+
+	if recordType == RecordTypePTR {
+		if net.ParseIP(rc.InputName) == nil {
+			reasons = append(reasons, fmt.Sprintf("PTR input name %q must be an IP address", rc.InputName))
+		}
+	} else if err := validateDNSName(rc.InputName, true); err != nil {
+		reasons = append(reasons, fmt.Sprintf("input name %q %s", rc.InputName, err))
+	}
+
+	switch rc.ChangeType {
+	case ChangeTypeAdd:
+		if rc.TTL != 0 && (rc.TTL < MinTTL || rc.TTL > MaxTTL) {
+			reasons = append(reasons, fmt.Sprintf("TTL %d must be between %d and %d", rc.TTL, MinTTL, MaxTTL))
+		}
+		if rc.Record == (RecordData{}) {
+			return append(reasons, "an Add requires record data")
+		}
+	case ChangeTypeDeleteRecordSet:
+		if rc.Record == (RecordData{}) {
+			return reasons
+		}
+	default:
+		return append(reasons, fmt.Sprintf("invalid change type %q; want %s or %s", rc.ChangeType, ChangeTypeAdd, ChangeTypeDeleteRecordSet))
+	}
+
+	return append(reasons, validateRecordData(recordType, rc.Record)...)
+}
+
+// validateRecordData returns the reasons d is invalid
+// record data of the record type, if any.
+func validateRecordData(recordType RecordType, d RecordData) []string {
+	reasons := []string{}
+	name := func(field, value string) {
+		if err := validateDNSName(value, false); err != nil {
+			reasons = append(reasons, fmt.Sprintf("%s.%s %q %s", recordType, field, value, err))
+		}
+	}
+	uint16Field := func(field string, value int) {
+		if value < 0 || value > math.MaxUint16 {
+			reasons = append(reasons, fmt.Sprintf("%s.%s %d must be between 0 and %d", recordType, field, value, math.MaxUint16))
+		}
+	}
+
+	switch recordType {
+	case RecordTypeA:
+		if ip := net.ParseIP(d.Address); ip == nil || ip.To4() == nil {
+			reasons = append(reasons, fmt.Sprintf("A.address %q must be an IPv4 address", d.Address))
+		}
+	case RecordTypeAAAA:
+		if ip := net.ParseIP(d.Address); ip == nil || ip.To4() != nil {
+			reasons = append(reasons, fmt.Sprintf("AAAA.address %q must be an IPv6 address", d.Address))
+		}
+	case RecordTypeCNAME:
+		name("cname", d.CName)
+	case RecordTypeMX:
+		uint16Field("preference", d.Preference)
+		name("exchange", d.Exchange)
+	case RecordTypeNAPTR:
+		uint16Field("order", d.Order)
+		uint16Field("preference", d.Preference)
+		if strings.ContainsFunc(d.Flags, func(r rune) bool {
+			return (r < 'A' || r > 'Z') && (r < 'a' || r > 'z') && (r < '0' || r > '9')
+		}) {
+			reasons = append(reasons, fmt.Sprintf("NAPTR.flags %q must be alphanumeric", d.Flags))
+		}
+		if d.Replacement != "." {
+			name("replacement", d.Replacement)
+		}
+	case RecordTypeNS:
+		name("nsdname", d.NSDName)
+	case RecordTypePTR:
+		name("ptrdname", d.PTRDName)
+	case RecordTypeSRV:
+		uint16Field("priority", d.Priority)
+		uint16Field("weight", d.Weight)
+		uint16Field("port", d.Port)
+		if d.Target != "." {
+			name("target", d.Target)
+		}
+	case RecordTypeSSHFP:
+		if d.Algorithm < 1 || d.Algorithm > math.MaxUint8 {
+			reasons = append(reasons, fmt.Sprintf("SSHFP.algorithm %d must be between 1 and %d", d.Algorithm, math.MaxUint8))
+		}
+		if d.FingerprintType < 1 || d.FingerprintType > math.MaxUint8 {
+			reasons = append(reasons, fmt.Sprintf("SSHFP.type %d must be between 1 and %d", d.FingerprintType, math.MaxUint8))
+		}
+		if _, err := hex.DecodeString(d.Fingerprint); err != nil || d.Fingerprint == "" {
+			reasons = append(reasons, fmt.Sprintf("SSHFP.fingerprint %q must be hexadecimal", d.Fingerprint))
+		}
+	case RecordTypeTXT:
+		if d.Text == "" {
+			reasons = append(reasons, "TXT.text is required")
+		}
+	}
+
+	return reasons
+}
+
+// validateDNSName returns an error describing why name isn't a valid domain
+// name, which may be relative or fully qualified. Names whose first label is
+// "*" are only valid if wildcard is set.
+func validateDNSName(name string, wildcard bool) error {
+	if name == "" {
+		return fmt.Errorf("is required")
+	}
+	if len(absoluteName(name)) > 255 {
+		return fmt.Errorf("must be at most 255 characters")
+	}
+
+	for i, label := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		switch {
+		case label == "":
+			return fmt.Errorf("must not have empty labels")
+		case len(label) > 63:
+			return fmt.Errorf("must not have labels longer than 63 characters")
+		case label == "*" && i == 0 && wildcard:
+			continue
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+				return fmt.Errorf("must only contain letters, digits, hyphens and underscores")
+			}
+		}
+	}
+
+	return nil
+}
+
+// batchChangeConflicts returns the problems of changes that are
+// valid alone but conflict with others of the same batch change.
+func batchChangeConflicts(changes []RecordChange) []BatchChangeProblem {
+	problems := []BatchChangeProblem{}
+
+	seen := map[RecordChange]int{}
+	addedTypes := map[string][]string{}
+	for i, rc := range changes {
+		key := RecordChange{
+			ChangeType: rc.ChangeType,
+			InputName:  strings.ToLower(absoluteName(rc.InputName)),
+			Type:       rc.Type,
+			Record:     rc.Record,
+		}
+		if first, ok := seen[key]; ok {
+			problems = append(problems, BatchChangeProblem{Index: i, Change: rc, Reason: fmt.Sprintf("duplicates change %d", first)})
+			continue
+		}
+		seen[key] = i
+
+		if rc.ChangeType != ChangeTypeAdd {
+			continue
+		}
+		for _, t := range addedTypes[key.InputName] {
+			reason := ""
+			switch {
+			case rc.Type == string(RecordTypeCNAME) && t == rc.Type:
+				reason = "a CNAME record set may only have one record"
+			case rc.Type == string(RecordTypeCNAME) || t == string(RecordTypeCNAME):
+				reason = fmt.Sprintf("conflicts with the %s added to the same name", t)
+			}
+			if reason != "" {
+				problems = append(problems, BatchChangeProblem{Index: i, Change: rc, Reason: reason})
+				break
+			}
+		}
+		if !contains(addedTypes[key.InputName], rc.Type) {
+			addedTypes[key.InputName] = append(addedTypes[key.InputName], rc.Type)
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestBatchChangeBuilder(t *testing.T) {
+	change, err := NewBatchChangeBuilder().
+		Comments("migrate www").
+		OwnerGroupID("group-id").
+		DeleteRecordSet("www.ok.", RecordTypeCNAME).
+		AddRecordSet("www.ok.", RecordTypeA, 300, NewARecord("10.0.0.1"), NewARecord("10.0.0.2")).
+		AddRecordSet("1.0.0.10", RecordTypePTR, 0, NewPTRRecord("www.ok.")).
+		AddRecordSet("_sip._udp.ok.", RecordTypeSRV, 300, NewSRVRecord(0, 0, 5060, "sip.ok.")).
+		DeleteRecordSetEntry("ok.", RecordTypeMX, NewMXRecord(10, "mx.ok.")).
+		AddRecordSet("ok.", RecordTypeSSHFP, 300, NewSSHFPRecord(6, 2, "abcdef")).
+		AddRecordSet("ok.", RecordTypeNAPTR, 300, NewNAPTRRecord(100, 10, "", "", "", "sip.ok.")).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := &BatchRecordChange{
+		Comments:     "migrate www",
+		OwnerGroupID: "group-id",
+		Changes: []RecordChange{
+			{ChangeType: ChangeTypeDeleteRecordSet, InputName: "www.ok.", Type: "CNAME"},
+			{ChangeType: ChangeTypeAdd, InputName: "www.ok.", Type: "A", TTL: 300, Record: RecordData{Address: "10.0.0.1"}},
+			{ChangeType: ChangeTypeAdd, InputName: "www.ok.", Type: "A", TTL: 300, Record: RecordData{Address: "10.0.0.2"}},
+			{ChangeType: ChangeTypeAdd, InputName: "1.0.0.10", Type: "PTR", Record: RecordData{PTRDName: "www.ok."}},
+			{ChangeType: ChangeTypeAdd, InputName: "_sip._udp.ok.", Type: "SRV", TTL: 300, Record: RecordData{Port: 5060, Target: "sip.ok."}},
+			{ChangeType: ChangeTypeDeleteRecordSet, InputName: "ok.", Type: "MX", Record: RecordData{Preference: 10, Exchange: "mx.ok."}},
+			{ChangeType: ChangeTypeAdd, InputName: "ok.", Type: "SSHFP", TTL: 300, Record: RecordData{Algorithm: 6, FingerprintType: 2, Fingerprint: "abcdef"}},
+			{ChangeType: ChangeTypeAdd, InputName: "ok.", Type: "NAPTR", TTL: 300, Record: RecordData{Order: 100, Preference: 10, Replacement: "sip.ok."}},
+		},
+	}
+	if !reflect.DeepEqual(change, expected) {
+		t.Errorf("Expected %+v; got %+v", expected, change)
+	}
+}
+
+func TestBatchChangeBuilderProblems(t *testing.T) {
+	_, err := NewBatchChangeBuilder().
+		AddRecordSet("www.ok.", RecordTypeA, 10, NewARecord("2001:db8::1")).
+		AddRecordSet("www.ok", RecordTypeCNAME, 300, NewCNAMERecord("elsewhere.ok.")).
+		AddRecordSet("alias.ok.", RecordTypeCNAME, 300, NewCNAMERecord("a.ok."), NewCNAMERecord("b.ok.")).
+		AddRecordSet("mail.ok.", RecordTypeMX, 300).
+		AddRecordSet("www.ok.", RecordTypePTR, 300, NewPTRRecord("www.ok.")).
+		AddRecordSet("bad name.ok.", RecordTypeTXT, 300, NewTXTRecord("text")).
+		AddRecordSet("ok.", RecordTypeSOA, 300, NewSOARecord("ns.ok.", "admin.ok.", 1, 2, 3, 4, 5)).
+		AddRecordSet("ok.", RecordTypeSSHFP, 300, NewSSHFPRecord(0, 1, "not hex")).
+		DeleteRecordSet("gone.ok.", RecordTypeA).
+		DeleteRecordSet("GONE.ok", RecordTypeA).
+		Build()
+
+	var buildErr *BatchChangeBuildError
+	if !errors.As(err, &buildErr) {
+		t.Fatalf("Expected a *BatchChangeBuildError; got %v", err)
+	}
+
+	expected := []string{
+		`change 0 (Add www.ok. A): TTL 10 must be between 30 and 2147483647`,
+		`change 0 (Add www.ok. A): A.address "2001:db8::1" must be an IPv4 address`,
+		`change 1 (Add www.ok CNAME): conflicts with the A added to the same name`,
+		`change 3 (Add alias.ok. CNAME): a CNAME record set may only have one record`,
+		`change 4 (Add mail.ok. MX): an Add requires record data`,
+		`change 5 (Add www.ok. PTR): PTR input name "www.ok." must be an IP address`,
+		`change 5 (Add www.ok. PTR): conflicts with the CNAME added to the same name`,
+		`change 6 (Add bad name.ok. TXT): input name "bad name.ok." must only contain letters, digits, hyphens and underscores`,
+		`change 7 (Add ok. SOA): batch changes don't support "SOA" records`,
+		`change 8 (Add ok. SSHFP): SSHFP.algorithm 0 must be between 1 and 255`,
+		`change 8 (Add ok. SSHFP): SSHFP.fingerprint "not hex" must be hexadecimal`,
+		`change 10 (DeleteRecordSet GONE.ok A): duplicates change 9`,
+	}
+	got := make([]string, len(buildErr.Problems))
+	for i, p := range buildErr.Problems {
+		got[i] = p.String()
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected problems:\n%s\ngot:\n%s", strings.Join(expected, "\n"), strings.Join(got, "\n"))
+	}
+	if len(buildErr.Change.Changes) != 11 {
+		t.Errorf("Expected the error to carry the 11 built changes; got %d", len(buildErr.Change.Changes))
+	}
+
+	if _, err := NewBatchChangeBuilder().Build(); err == nil || err.Error() != "invalid batch change: a batch change requires at least one change" {
+		t.Errorf("Expected an error for an empty batch change; got %v", err)
+	}
+}
+
+func TestValidateDNSName(t *testing.T) {
+	tests := []struct {
+		name     string
+		wildcard bool
+		valid    bool
+	}{
+		{"www.ok.", false, true},
+		{"www.ok", false, true},
+		{"_dmarc.ok.", false, true},
+		{"*.ok.", true, true},
+		{"*.ok.", false, false},
+		{"www.*.ok.", true, false},
+		{"www..ok.", false, false},
+		{"", false, false},
+		{strings.Repeat("a", 64) + ".ok.", false, false},
+		{strings.Repeat(strings.Repeat("a", 63)+".", 4), false, false},
+	}
+
+	for _, tt := range tests {
+		if err := validateDNSName(tt.name, tt.wildcard); (err == nil) != tt.valid {
+			t.Errorf("Expected validateDNSName(%q, %t) valid to be %t; got %v", tt.name, tt.wildcard, tt.valid, err)
+		}
+	}
+}
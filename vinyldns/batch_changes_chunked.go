@@ -0,0 +1,245 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// DefaultBatchChangeLimit is VinylDNS's default maximum
+// number of single changes in a batch change.
+const DefaultBatchChangeLimit = 1000
+
+// ErrBatchChunkNotSubmitted is the error of a chunk of a chunked batch change
+// that wasn't submitted, because an earlier chunk failed or ctx was done.
+var ErrBatchChunkNotSubmitted = errors.New("vinyldns: batch change chunk not submitted")
+
+// ChunkOptions configures BatchRecordChangeCreateChunked.
+type ChunkOptions struct {
+	// MaxChanges is the maximum number of single changes per batch change,
+	// which should match the server's limit. If 0, DefaultBatchChangeLimit is used.
+	MaxChanges int
+
+	// Concurrency is the number of chunks submitted at once.
+	// If 0 or 1, chunks are submitted sequentially, in order.
+	Concurrency int
+
+	// ContinueOnError submits the remaining chunks after a chunk fails,
+	// rather than leaving them unsubmitted.
+	ContinueOnError bool
+
+	// Create configures the creation of each chunk, and may be nil.
+	Create *BatchChangeCreateOptions
+}
+
+// BatchChunk is a single batch change submitted by BatchRecordChangeCreateChunked.
+type BatchChunk struct {
+	Changes []RecordChange
+
+	// ID and Status are those of the created batch change.
+	ID     string
+	Status string
+
+	Response *BatchRecordChangeUpdateResponse
+
+	// Err is the error the chunk failed with, if any,
+	// or ErrBatchChunkNotSubmitted if it wasn't submitted.
+	Err error
+}
+
+// ChunkedBatchChangeResult is the result of BatchRecordChangeCreateChunked.
+type ChunkedBatchChangeResult struct {
+	Chunks []BatchChunk
+}
+
+// IDs returns the IDs of the batch changes created, in chunk order.
+func (r *ChunkedBatchChangeResult) IDs() []string {
+	ids := []string{}
+	for _, chunk := range r.Chunks {
+		if chunk.ID != "" {
+			ids = append(ids, chunk.ID)
+		}
+	}
+
+	return ids
+}
+
+// Failed returns the chunks that failed or weren't submitted.
+func (r *ChunkedBatchChangeResult) Failed() []BatchChunk {
+	failed := []BatchChunk{}
+	for _, chunk := range r.Chunks {
+		if chunk.Err != nil {
+			failed = append(failed, chunk)
+		}
+	}
+
+	return failed
+}
+
+// BatchRecordChangeCreateChunked creates the batch record change it's passed
+// as one or more batch changes of at most opts.MaxChanges single changes each.
+// See BatchRecordChangeCreateChunkedWithContext.
+func (c *Client) BatchRecordChangeCreateChunked(change *BatchRecordChange, opts *ChunkOptions) (*ChunkedBatchChangeResult, error) {
+	return c.BatchRecordChangeCreateChunkedWithContext(context.Background(), change, opts)
+}
+
+// BatchRecordChangeCreateChunkedWithContext creates the batch record change
+// it's passed as one or more batch changes of at most opts.MaxChanges single
+// changes each, using ctx for the lifetime of the requests. Changes of the same
+// input name are kept in the same chunk, so that, for example, a DeleteRecordSet
+// and its replacing Add are applied together. Input names are assigned to chunks
+// in the order they first appear, and each chunk keeps its changes in their input
+// order. Each chunk carries the change's comments, owner group and other attributes.
+//
+// The result lists every chunk, including those that failed or weren't
+// submitted; the error joins those of the chunks that failed. It's an error,
+// before anything is submitted, for the changes of a single input name to
+// exceed opts.MaxChanges.
+func (c *Client) BatchRecordChangeCreateChunkedWithContext(ctx context.Context, change *BatchRecordChange, opts *ChunkOptions) (*ChunkedBatchChangeResult, error) {
+	if opts == nil {
+		opts = &ChunkOptions{}
+	}
+	maxChanges := opts.MaxChanges
+	if maxChanges <= 0 {
+		maxChanges = DefaultBatchChangeLimit
+	}
+
+	chunks, err := chunkRecordChanges(change.Changes, maxChanges)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ChunkedBatchChangeResult{Chunks: make([]BatchChunk, len(chunks))}
+	for i, changes := range chunks {
+		result.Chunks[i] = BatchChunk{Changes: changes, Err: ErrBatchChunkNotSubmitted}
+	}
+
+	workers := opts.Concurrency
+	if workers < 1 {
+		workers = 1
+	}
+	jobs := make(chan int)
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		failed bool
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				mu.Lock()
+				skip := failed && !opts.ContinueOnError
+				mu.Unlock()
+				if skip || ctx.Err() != nil {
+					continue
+				}
+
+				chunk := *change
+				chunk.Changes = result.Chunks[i].Changes
+				resp, err := c.BatchRecordChangeSubmitWithContext(ctx, &chunk, opts.Create)
+
+				mu.Lock()
+				result.Chunks[i].Err = err
+				if err != nil {
+					failed = true
+				} else {
+					result.Chunks[i].Response = resp
+					result.Chunks[i].ID = resp.ID
+					result.Chunks[i].Status = resp.Status
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	for i := range chunks {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	errs := []error{}
+	notSubmitted := false
+	for i, chunk := range result.Chunks {
+		switch {
+		case errors.Is(chunk.Err, ErrBatchChunkNotSubmitted):
+			notSubmitted = true
+		case chunk.Err != nil:
+			errs = append(errs, fmt.Errorf("chunk %d of %d: %w", i+1, len(chunks), chunk.Err))
+		}
+	}
+	if err := ctx.Err(); err != nil && notSubmitted {
+		errs = append(errs, err)
+	}
+
+	return result, errors.Join(errs...)
+}
+
+// chunkRecordChanges splits changes into chunks of at most maxChanges,
+// keeping the changes of each input name together. Input names are assigned
+// to chunks in the order they first appear, and each chunk keeps its changes
+// in their input order.
+func chunkRecordChanges(changes []RecordChange, maxChanges int) ([][]RecordChange, error) {
+	if len(changes) == 0 {
+		return nil, fmt.Errorf("a batch change requires at least one change")
+	}
+
+	names := []string{}
+	inputNames := map[string]string{}
+	counts := map[string]int{}
+	for _, rc := range changes {
+		name := chunkKey(rc)
+		if _, ok := counts[name]; !ok {
+			names = append(names, name)
+			inputNames[name] = rc.InputName
+		}
+		counts[name]++
+	}
+
+	chunkOf := map[string]int{}
+	chunk, size := 0, 0
+	for _, name := range names {
+		count := counts[name]
+		if count > maxChanges {
+			return nil, fmt.Errorf("the %d changes of %s exceed the maximum of %d changes per batch change", count, inputNames[name], maxChanges)
+		}
+		if size+count > maxChanges {
+			chunk, size = chunk+1, 0
+		}
+		chunkOf[name] = chunk
+		size += count
+	}
+
+	chunks := make([][]RecordChange, chunk+1)
+	for _, rc := range changes {
+		i := chunkOf[chunkKey(rc)]
+		chunks[i] = append(chunks[i], rc)
+	}
+
+	return chunks, nil
+}
+
+// chunkKey returns the normalized input name of rc, by which changes are grouped.
+func chunkKey(rc RecordChange) string {
+	if ip := net.ParseIP(rc.InputName); ip != nil {
+		return ip.String()
+	}
+
+	return strings.ToLower(absoluteName(rc.InputName))
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestChunkRecordChanges(t *testing.T) {
+	changes := []RecordChange{
+		{ChangeType: ChangeTypeDeleteRecordSet, InputName: "a.ok.", Type: "CNAME"},
+		{ChangeType: ChangeTypeAdd, InputName: "b.ok.", Type: "A"},
+		{ChangeType: ChangeTypeAdd, InputName: "A.ok", Type: "A"},
+		{ChangeType: ChangeTypeAdd, InputName: "c.ok.", Type: "A"},
+		{ChangeType: ChangeTypeAdd, InputName: "2001:db8::1", Type: "PTR"},
+		{ChangeType: ChangeTypeAdd, InputName: "d.ok.", Type: "A"},
+		{ChangeType: ChangeTypeDeleteRecordSet, InputName: "2001:DB8:0::1", Type: "PTR"},
+	}
+
+	chunks, err := chunkRecordChanges(changes, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := [][]string{}
+	for _, chunk := range chunks {
+		names := []string{}
+		for _, rc := range chunk {
+			names = append(names, rc.InputName)
+		}
+		got = append(got, names)
+	}
+	expected := [][]string{
+		{"a.ok.", "b.ok.", "A.ok"},
+		{"c.ok.", "2001:db8::1", "2001:DB8:0::1"},
+		{"d.ok."},
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected chunks %v; got %v", expected, got)
+	}
+
+	if _, err := chunkRecordChanges(changes, 1); err == nil || !strings.Contains(err.Error(), "the 2 changes of a.ok.") {
+		t.Errorf("Expected an error for an input name whose changes exceed the maximum; got %v", err)
+	}
+	if _, err := chunkRecordChanges(nil, 3); err == nil {
+		t.Error("Expected an error for a batch change without changes")
+	}
+}
+
+func TestBatchRecordChangeCreateChunked(t *testing.T) {
+	createJSON, err := readFile("test-fixtures/batch-changes/batch-change-create.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges",
+			code:     200,
+			body:     createJSON,
+		},
+	})
+	defer server.Close()
+
+	change := &BatchRecordChange{Comments: "migration"}
+	for _, name := range []string{"a.ok.", "b.ok.", "c.ok."} {
+		change.Changes = append(change.Changes, RecordChange{ChangeType: ChangeTypeAdd, InputName: name, Type: "A", Record: RecordData{Address: "10.0.0.1"}})
+	}
+
+	result, err := client.BatchRecordChangeCreateChunked(change, &ChunkOptions{MaxChanges: 2, Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.Chunks) != 2 || len(result.Failed()) != 0 || result.Chunks[1].Response == nil {
+		t.Errorf("Expected 2 created chunks; got %+v", result.Chunks)
+	}
+	if len(change.Changes) != 3 {
+		t.Errorf("Expected the change passed to be unmodified; got %d changes", len(change.Changes))
+	}
+
+	server.Close()
+	result, err = client.BatchRecordChangeCreateChunked(change, &ChunkOptions{MaxChanges: 2})
+	if err == nil || !strings.Contains(err.Error(), "chunk 1 of 2") {
+		t.Errorf("Expected the first chunk's error; got %v", err)
+	}
+	if !errors.Is(result.Chunks[1].Err, ErrBatchChunkNotSubmitted) {
+		t.Errorf("Expected the second chunk not to be submitted; got %v", result.Chunks[1].Err)
+	}
+}
@@ -0,0 +1,235 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// BatchRecordTypes returns every record type supported by batch changes.
+func BatchRecordTypes() []RecordType {
+	return []RecordType{
+		RecordTypeA,
+		RecordTypeAAAA,
+		RecordTypeCNAME,
+		RecordTypeMX,
+		RecordTypeNAPTR,
+		RecordTypeNS,
+		RecordTypePTR,
+		RecordTypeSRV,
+		RecordTypeSSHFP,
+		RecordTypeTXT,
+	}
+}
+
+// isBatchRecordType reports whether batch changes support records of type t.
+func isBatchRecordType(t RecordType) bool {
+	for _, bt := range BatchRecordTypes() {
+		if bt == t {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NewRecordData returns the batch change record data of r.
+// Fields of r that batch changes don't support, such as
+// those of SOA and DS records, are dropped.
+func NewRecordData(r Record) RecordData {
+	return RecordData{
+		Address:         r.Address,
+		CName:           r.CName,
+		Preference:      r.Preference,
+		Exchange:        r.Exchange,
+		NSDName:         r.NSDName,
+		PTRDName:        r.PTRDName,
+		Text:            r.Text,
+		Priority:        r.Priority,
+		Weight:          r.Weight,
+		Port:            r.Port,
+		Target:          r.Target,
+		Algorithm:       r.Algorithm,
+		FingerprintType: r.FingerprintType,
+		Fingerprint:     r.Fingerprint,
+		Order:           r.Order,
+		Flags:           r.Flags,
+		Service:         r.Service,
+		Regexp:          r.Regexp,
+		Replacement:     r.Replacement,
+	}
+}
+
+// Record returns the record set Record of the record data.
+func (d RecordData) Record() Record {
+	return Record{
+		Address:         d.Address,
+		CName:           d.CName,
+		Preference:      d.Preference,
+		Exchange:        d.Exchange,
+		NSDName:         d.NSDName,
+		PTRDName:        d.PTRDName,
+		Text:            d.Text,
+		Priority:        d.Priority,
+		Weight:          d.Weight,
+		Port:            d.Port,
+		Target:          d.Target,
+		Algorithm:       d.Algorithm,
+		FingerprintType: d.FingerprintType,
+		Fingerprint:     d.Fingerprint,
+		Order:           d.Order,
+		Flags:           d.Flags,
+		Service:         d.Service,
+		Regexp:          d.Regexp,
+		Replacement:     d.Replacement,
+	}
+}
+
+// RecordChangesFromRecordSet returns the batch record changes of the
+// changeType, ChangeTypeAdd or ChangeTypeDeleteRecordSet, of rs: one per
+// record, or, for a DeleteRecordSet of a record set without records, a single
+// change deleting the whole record set. The changes' input name is rs.FQDN
+// or, if it's empty, rs.Name qualified by rs.ZoneName; that of a PTR record
+// set in a reverse zone is its IP address, as batch changes require.
+func RecordChangesFromRecordSet(rs RecordSet, changeType string) ([]RecordChange, error) {
+	if changeType != ChangeTypeAdd && changeType != ChangeTypeDeleteRecordSet {
+		return nil, fmt.Errorf("invalid change type %q; want %s or %s", changeType, ChangeTypeAdd, ChangeTypeDeleteRecordSet)
+	}
+	if !isBatchRecordType(RecordType(rs.Type)) {
+		return nil, fmt.Errorf("record set %q: batch changes don't support %s records", rs.Name, rs.Type)
+	}
+
+	inputName, err := batchInputName(rs)
+	if err != nil {
+		return nil, err
+	}
+
+	change := RecordChange{
+		ChangeType: changeType,
+		InputName:  inputName,
+		Type:       rs.Type,
+	}
+	if changeType == ChangeTypeAdd {
+		if len(rs.Records) == 0 {
+			return nil, fmt.Errorf("record set %q: an Add requires records", inputName)
+		}
+		change.TTL = rs.TTL
+	}
+	if len(rs.Records) == 0 {
+		return []RecordChange{change}, nil
+	}
+
+	changes := make([]RecordChange, len(rs.Records))
+	for i, r := range rs.Records {
+		changes[i] = change
+		changes[i].Record = NewRecordData(r)
+	}
+
+	return changes, nil
+}
+
+// batchInputName returns the batch change input name of rs.
+func batchInputName(rs RecordSet) (string, error) {
+	name := rs.FQDN
+	if name == "" {
+		switch {
+		case rs.ZoneName == "" && strings.HasSuffix(rs.Name, "."):
+			name = rs.Name
+		case rs.ZoneName == "":
+			return "", fmt.Errorf("record set %q has neither an FQDN nor a ZoneName", rs.Name)
+		case rs.Name == "" || rs.Name == "@" || strings.EqualFold(absoluteName(rs.Name), absoluteName(rs.ZoneName)):
+			name = absoluteName(rs.ZoneName)
+		default:
+			name = rs.Name + "." + absoluteName(rs.ZoneName)
+		}
+	}
+
+	if RecordType(rs.Type) == RecordTypePTR {
+		if ip := reverseNameIP(name); ip != nil {
+			return ip.String(), nil
+		}
+	}
+
+	return name, nil
+}
+
+// reverseNameIP returns the IP address of the fully qualified
+// in-addr.arpa or ip6.arpa name, or nil if name isn't one.
+func reverseNameIP(name string) net.IP {
+	labels := strings.Split(strings.ToLower(strings.TrimSuffix(name, ".")), ".")
+
+	switch {
+	case len(labels) == 6 && strings.HasSuffix(strings.ToLower(name), ".in-addr.arpa."):
+		ip := make(net.IP, net.IPv4len)
+		for i := 0; i < net.IPv4len; i++ {
+			b, err := strconv.ParseUint(labels[3-i], 10, 8)
+			if err != nil {
+				return nil
+			}
+			ip[i] = byte(b)
+		}
+		return ip
+	case len(labels) == 34 && strings.HasSuffix(strings.ToLower(name), ".ip6.arpa."):
+		ip := make(net.IP, net.IPv6len)
+		for i := 0; i < 32; i++ {
+			n, err := strconv.ParseUint(labels[31-i], 16, 4)
+			if err != nil || len(labels[31-i]) != 1 {
+				return nil
+			}
+			ip[i/2] |= byte(n) << (4 * (1 - i%2))
+		}
+		return ip
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the record change, encoding its record data with
+// exactly the fields of the change's type, so that meaningful zero values
+// such as an SRV weight of 0 are preserved. Empty record data, such as that
+// of a DeleteRecordSet of a whole record set, is omitted.
+func (rc RecordChange) MarshalJSON() ([]byte, error) {
+	type recordChange RecordChange
+
+	fields, ok := recordTypeFields[RecordType(rc.Type)]
+	if !ok || rc.Record == (RecordData{}) {
+		return json.Marshal(struct {
+			recordChange
+			Record *RecordData `json:"record,omitempty"`
+		}{recordChange(rc), recordData(rc.Record)})
+	}
+
+	r := rc.Record.Record()
+	record := map[string]interface{}{}
+	for _, f := range fields {
+		record[f] = r.field(f)
+	}
+
+	return json.Marshal(struct {
+		recordChange
+		Record map[string]interface{} `json:"record"`
+	}{recordChange(rc), record})
+}
+
+// recordData returns a pointer to d, or nil if d is empty.
+func recordData(d RecordData) *RecordData {
+	if d == (RecordData{}) {
+		return nil
+	}
+
+	return &d
+}
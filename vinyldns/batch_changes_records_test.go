@@ -0,0 +1,133 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRecordDataRoundTrip(t *testing.T) {
+	records := []Record{
+		NewMXRecord(10, "mx.ok."),
+		NewNAPTRRecord(100, 10, "U", "E2U+sip", "!^.*$!sip:info@ok!", "."),
+		NewSRVRecord(0, 0, 5060, "sip.ok."),
+		NewSSHFPRecord(1, 2, "123456789abcdef67890123456789abcdef67890"),
+		NewTXTRecord("v=spf1 -all"),
+	}
+
+	for _, r := range records {
+		if got := NewRecordData(r).Record(); !reflect.DeepEqual(got, r) {
+			t.Errorf("Expected %+v to round trip; got %+v", r, got)
+		}
+	}
+}
+
+func TestRecordChangesFromRecordSet(t *testing.T) {
+	rs := RecordSet{
+		Name:     "mail",
+		ZoneName: "ok",
+		Type:     "MX",
+		TTL:      300,
+		Records:  []Record{NewMXRecord(10, "mx1.ok."), NewMXRecord(20, "mx2.ok.")},
+	}
+
+	changes, err := RecordChangesFromRecordSet(rs, ChangeTypeAdd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []RecordChange{
+		{ChangeType: ChangeTypeAdd, InputName: "mail.ok.", Type: "MX", TTL: 300, Record: RecordData{Preference: 10, Exchange: "mx1.ok."}},
+		{ChangeType: ChangeTypeAdd, InputName: "mail.ok.", Type: "MX", TTL: 300, Record: RecordData{Preference: 20, Exchange: "mx2.ok."}},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected %+v; got %+v", expected, changes)
+	}
+
+	rs.Records = nil
+	changes, err = RecordChangesFromRecordSet(rs, ChangeTypeDeleteRecordSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []RecordChange{{ChangeType: ChangeTypeDeleteRecordSet, InputName: "mail.ok.", Type: "MX"}}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("Expected %+v; got %+v", expected, changes)
+	}
+
+	if _, err := RecordChangesFromRecordSet(rs, ChangeTypeAdd); err == nil {
+		t.Error("Expected an error adding a record set without records")
+	}
+	if _, err := RecordChangesFromRecordSet(RecordSet{FQDN: "ok.", Type: "SOA"}, ChangeTypeDeleteRecordSet); err == nil {
+		t.Error("Expected an error for an SOA record set")
+	}
+	if _, err := RecordChangesFromRecordSet(rs, "Update"); err == nil {
+		t.Error("Expected an error for an invalid change type")
+	}
+}
+
+func TestRecordChangesFromRecordSetInputName(t *testing.T) {
+	tests := []struct {
+		rs       RecordSet
+		expected string
+	}{
+		{RecordSet{FQDN: "www.ok.", Name: "ignored", ZoneName: "ok.", Type: "A"}, "www.ok."},
+		{RecordSet{Name: "www", ZoneName: "ok.", Type: "A"}, "www.ok."},
+		{RecordSet{Name: "@", ZoneName: "ok.", Type: "A"}, "ok."},
+		{RecordSet{Name: "ok.", ZoneName: "ok.", Type: "A"}, "ok."},
+		{RecordSet{Name: "www.ok.", Type: "A"}, "www.ok."},
+		{RecordSet{Name: "4", ZoneName: "3.2.1.in-addr.arpa.", Type: "PTR"}, "1.2.3.4"},
+		{RecordSet{FQDN: "1.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.0.8.b.d.0.1.0.0.2.ip6.arpa.", Type: "PTR"}, "2001:db8::1"},
+		{RecordSet{Name: "host", ZoneName: "ok.", Type: "PTR"}, "host.ok."},
+	}
+
+	for _, tt := range tests {
+		changes, err := RecordChangesFromRecordSet(tt.rs, ChangeTypeDeleteRecordSet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectSame(t, changes[0].InputName, tt.expected, "InputName of "+tt.rs.Name+tt.rs.FQDN)
+	}
+
+	if _, err := RecordChangesFromRecordSet(RecordSet{Name: "www", Type: "A"}, ChangeTypeDeleteRecordSet); err == nil {
+		t.Error("Expected an error for a relative name without a zone name")
+	}
+}
+
+func TestRecordChangeMarshalJSON(t *testing.T) {
+	tests := []struct {
+		change   RecordChange
+		expected string
+	}{
+		{
+			RecordChange{ChangeType: ChangeTypeAdd, InputName: "_sip._udp.ok.", Type: "SRV", TTL: 300, Record: NewRecordData(NewSRVRecord(0, 0, 5060, "sip.ok."))},
+			`{"changeType":"Add","ttl":300,"type":"SRV","inputName":"_sip._udp.ok.","record":{"port":5060,"priority":0,"target":"sip.ok.","weight":0}}`,
+		},
+		{
+			RecordChange{ChangeType: ChangeTypeDeleteRecordSet, InputName: "www.ok.", Type: "A"},
+			`{"changeType":"DeleteRecordSet","type":"A","inputName":"www.ok."}`,
+		},
+		{
+			RecordChange{ChangeType: ChangeTypeAdd, InputName: "www.ok.", Type: "A", Record: RecordData{Address: "10.0.0.1"}},
+			`{"changeType":"Add","type":"A","inputName":"www.ok.","record":{"address":"10.0.0.1"}}`,
+		},
+	}
+
+	for _, tt := range tests {
+		b, err := json.Marshal(tt.change)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectSame(t, string(b), tt.expected, "encoded "+tt.change.Type+" change")
+	}
+}
@@ -12,12 +12,111 @@ limitations under the License.
 
 package vinyldns
 
+import "time"
+
 // BatchRecordChanges represents a list of record changes,
 // as returned by the list batch changes VinylDNS API endpoint.
+// See BatchChangeSummaries for the full list response.
 type BatchRecordChanges struct {
 	BatchChanges []RecordChange `json:"batchChanges,omitempty"`
 }
 
+// The approval statuses of a batch change.
+const (
+	ApprovalStatusAutoApproved     = "AutoApproved"
+	ApprovalStatusPendingReview    = "PendingReview"
+	ApprovalStatusManuallyApproved = "ManuallyApproved"
+	ApprovalStatusManuallyRejected = "ManuallyRejected"
+	ApprovalStatusCancelled        = "Cancelled"
+)
+
+// The statuses of a batch change.
+const (
+	BatchChangeStatusPendingProcessing = "PendingProcessing"
+	BatchChangeStatusPendingReview     = "PendingReview"
+	BatchChangeStatusScheduled         = "Scheduled"
+	BatchChangeStatusComplete          = "Complete"
+	BatchChangeStatusFailed            = "Failed"
+	BatchChangeStatusPartialFailure    = "PartialFailure"
+	BatchChangeStatusRejected          = "Rejected"
+	BatchChangeStatusCancelled         = "Cancelled"
+)
+
+// BatchChangeCreateOptions configures BatchRecordChangeSubmit.
+type BatchChangeCreateOptions struct {
+	// AllowManualReview, if false, rejects a batch change that would otherwise
+	// be held for manual review; the API allows manual review if nil.
+	AllowManualReview *bool
+
+	// ScheduledTime, if set, schedules the batch change to be applied no
+	// earlier than the time, overriding the change's ScheduledTime. The
+	// server must have scheduled changes enabled, and the time be in the future.
+	ScheduledTime time.Time
+}
+
+// BatchRecordChangesFilter represents the list query parameters that may be
+// passed to VinylDNS API endpoint /zones/batchrecordchanges
+type BatchRecordChangesFilter struct {
+	StartFrom int
+	MaxItems  int
+
+	// IgnoreAccess lists the batch changes of every user,
+	// rather than only the user's own. It requires a support user.
+	IgnoreAccess *bool
+
+	// ApprovalStatus is one of the ApprovalStatus constants, such as ApprovalStatusPendingReview.
+	ApprovalStatus string
+
+	// UserName is the name of the user who submitted the batch changes.
+	UserName string
+
+	// GroupName is the name of the batch changes' owner group.
+	GroupName string
+
+	// DateTimeRangeStart and DateTimeRangeEnd bound the batch
+	// changes' creation times; zero values are unbounded.
+	DateTimeRangeStart time.Time
+	DateTimeRangeEnd   time.Time
+
+	// ScheduledTimeStart and ScheduledTimeEnd bound the batch changes'
+	// scheduled times; zero values are unbounded. They aren't sent to the
+	// server, and are only applied by BatchRecordChangesScheduled.
+	ScheduledTimeStart time.Time
+	ScheduledTimeEnd   time.Time
+}
+
+// BatchChangeSummary represents a batch change as listed by
+// the list batch changes VinylDNS API endpoint.
+type BatchChangeSummary struct {
+	ID                 string `json:"id,omitempty"`
+	UserID             string `json:"userId,omitempty"`
+	UserName           string `json:"userName,omitempty"`
+	Comments           string `json:"comments,omitempty"`
+	CreatedTimestamp   string `json:"createdTimestamp,omitempty"`
+	TotalChanges       int    `json:"totalChanges"`
+	Status             string `json:"status,omitempty"`
+	OwnerGroupID       string `json:"ownerGroupId,omitempty"`
+	OwnerGroupName     string `json:"ownerGroupName,omitempty"`
+	ApprovalStatus     string `json:"approvalStatus,omitempty"`
+	ReviewerID         string `json:"reviewerId,omitempty"`
+	ReviewerName       string `json:"reviewerName,omitempty"`
+	ReviewComment      string `json:"reviewComment,omitempty"`
+	ReviewTimestamp    string `json:"reviewTimestamp,omitempty"`
+	ScheduledTime      string `json:"scheduledTime,omitempty"`
+	CancelledTimestamp string `json:"cancelledTimestamp,omitempty"`
+}
+
+// BatchChangeSummaries represents a page of batch changes,
+// as returned by the list batch changes VinylDNS API endpoint.
+type BatchChangeSummaries struct {
+	BatchChanges   []BatchChangeSummary `json:"batchChanges"`
+	StartFrom      int                  `json:"startFrom,omitempty"`
+	NextID         int                  `json:"nextId,omitempty"`
+	MaxItems       int                  `json:"maxItems"`
+	IgnoreAccess   bool                 `json:"ignoreAccess,omitempty"`
+	ApprovalStatus string               `json:"approvalStatus,omitempty"`
+}
+
 // RecordChange represents an individual batch record change.
 type RecordChange struct {
 	ID               string     `json:"id,omitempty"`
@@ -36,6 +135,9 @@ type RecordChange struct {
 	CreatedTimestamp string     `json:"createdTimestamp,omitempty"`
 	Record           RecordData `json:"record,omitempty"`
 	OwnerGroupID     string     `json:"ownerGroupId,omitempty"`
+	SystemMessage    string     `json:"systemMessage,omitempty"`
+	RecordChangeID   string     `json:"recordChangeId,omitempty"`
+	RecordSetID      string     `json:"recordSetId,omitempty"`
 }
 
 // BatchRecordChangeUpdateResponse is represents a batch record change create or update response
@@ -57,11 +159,53 @@ type BatchRecordChangeUpdateResponse struct {
 	CancelledTimestamp string         `json:"cancelledTimestamp,omitempty"`
 }
 
-// RecordData is represents a batch record change record data.
+// The change types of a batch record change.
+const (
+	ChangeTypeAdd             = "Add"
+	ChangeTypeDeleteRecordSet = "DeleteRecordSet"
+)
+
+// RecordData represents the record data of a batch record change.
+// Which fields apply depends on the type of the change; see BatchRecordTypes.
 type RecordData struct {
-	Address  string `json:"address,omitempty"`
-	CName    string `json:"cname,omitempty"`
+	// A and AAAA
+	Address string `json:"address,omitempty"`
+
+	// CNAME
+	CName string `json:"cname,omitempty"`
+
+	// MX and NAPTR
+	Preference int `json:"preference,omitempty"`
+
+	// MX
+	Exchange string `json:"exchange,omitempty"`
+
+	// NS
+	NSDName string `json:"nsdname,omitempty"`
+
+	// PTR
 	PTRDName string `json:"ptrdname,omitempty"`
+
+	// TXT
+	Text string `json:"text,omitempty"`
+
+	// SRV
+	Priority int    `json:"priority,omitempty"`
+	Weight   int    `json:"weight,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Target   string `json:"target,omitempty"`
+
+	// SSHFP
+	Algorithm       int    `json:"algorithm,omitempty"`
+	FingerprintType int    `json:"type,omitempty"`
+	Fingerprint     string `json:"fingerprint,omitempty"`
+
+	// NAPTR
+	Order       int    `json:"order,omitempty"`
+	Flags       string `json:"flags,omitempty"`
+	Service     string `json:"service,omitempty"`
+	Regexp      string `json:"regexp,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
 }
 
 // BatchRecordChange represents a batch record change API response.
@@ -87,3 +231,29 @@ type BatchRecordChange struct {
 type BatchChangeReview struct {
 	ReviewComment string `json:"reviewComment,omitempty"`
 }
+
+// ScheduledAt returns the time the batch change is scheduled for,
+// or false if it isn't scheduled.
+func (b *BatchRecordChange) ScheduledAt() (time.Time, bool) {
+	return parseTimestamp(b.ScheduledTime)
+}
+
+// ScheduledAt returns the time the batch change is scheduled for,
+// or false if it isn't scheduled.
+func (b BatchChangeSummary) ScheduledAt() (time.Time, bool) {
+	return parseTimestamp(b.ScheduledTime)
+}
+
+// parseTimestamp parses the RFC 3339 timestamp s, as
+// returned by the API, reporting false if it's empty or invalid.
+func parseTimestamp(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	return t, true
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// BatchRollbackPlan is the inverse of an applied batch change,
+// to be reviewed before it's submitted with BatchRecordChangeRollback.
+type BatchRollbackPlan struct {
+	// Original is the batch change rolled back.
+	Original *BatchRecordChange
+
+	// Change is the inverse batch change.
+	Change *BatchRecordChange
+
+	// Skipped lists the single changes of Original that
+	// weren't applied, and so aren't rolled back.
+	Skipped []RecordChange
+}
+
+// HasChanges reports whether submitting the plan would change anything.
+func (p *BatchRollbackPlan) HasChanges() bool {
+	return len(p.Change.Changes) != 0
+}
+
+// String renders the plan for review, one line per inverse change,
+// followed by the skipped single changes and a summary line.
+func (p *BatchRollbackPlan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Rollback of batch change %s:\n", p.Original.ID)
+
+	adds := 0
+	for _, rc := range p.Change.Changes {
+		switch {
+		case rc.ChangeType == ChangeTypeAdd:
+			adds++
+			fmt.Fprintf(&b, "  + %s %s ttl=%d %s\n", rc.InputName, rc.Type, rc.TTL, formatRecordChangeData(rc))
+		case rc.Record == (RecordData{}):
+			fmt.Fprintf(&b, "  - %s %s (record set)\n", rc.InputName, rc.Type)
+		default:
+			fmt.Fprintf(&b, "  - %s %s %s\n", rc.InputName, rc.Type, formatRecordChangeData(rc))
+		}
+	}
+	for _, rc := range p.Skipped {
+		fmt.Fprintf(&b, "  skipped %s %s %s (%s)\n", rc.ChangeType, rc.InputName, rc.Type, rc.Status)
+	}
+
+	fmt.Fprintf(&b, "%d to add, %d to delete, %d skipped.\n", adds, len(p.Change.Changes)-adds, len(p.Skipped))
+
+	return b.String()
+}
+
+func formatRecordChangeData(rc RecordChange) string {
+	data, err := FormatRecordData(RecordType(rc.Type), rc.Record.Record())
+	if err != nil {
+		return fmt.Sprintf("%+v", rc.Record)
+	}
+
+	return data
+}
+
+// BatchRecordChangeRollbackPlan returns the plan that rolls back the batch change
+// whose ID it's passed. See BatchRecordChangeRollbackPlanWithContext.
+func (c *Client) BatchRecordChangeRollbackPlan(changeID string) (*BatchRollbackPlan, error) {
+	return c.BatchRecordChangeRollbackPlanWithContext(context.Background(), changeID)
+}
+
+// BatchRecordChangeRollbackPlanWithContext returns the plan that rolls back the
+// batch change whose ID it's passed, using ctx for the lifetime of the requests.
+//
+// Each applied Add is inverted by a DeleteRecordSet of the added record, and each
+// applied DeleteRecordSet by Adds of the deleted records, whose data and TTL are
+// retrieved from the record set change history. A record set that was deleted
+// and re-added is deleted and restored to its records before the batch change.
+// Single changes that weren't applied are skipped. Only Complete and
+// PartialFailure batch changes can be rolled back.
+func (c *Client) BatchRecordChangeRollbackPlanWithContext(ctx context.Context, changeID string) (*BatchRollbackPlan, error) {
+	batch, err := c.BatchRecordChangeWithContext(ctx, changeID)
+	if err != nil {
+		return nil, err
+	}
+
+	history := map[string]*RecordSetChange{}
+	return newBatchRollbackPlan(batch, func(rc RecordChange) (*RecordSetChange, error) {
+		if rc.ZoneID == "" || rc.RecordSetID == "" || rc.RecordChangeID == "" {
+			return nil, fmt.Errorf("the change has no record set change")
+		}
+		if change, ok := history[rc.RecordChangeID]; ok {
+			return change, nil
+		}
+		change, err := c.RecordSetChangeWithContext(ctx, rc.ZoneID, rc.RecordSetID, rc.RecordChangeID)
+		if err != nil {
+			return nil, err
+		}
+		history[rc.RecordChangeID] = change
+
+		return change, nil
+	})
+}
+
+// BatchRecordChangeRollback submits the inverse batch change of the plan it's passed.
+func (c *Client) BatchRecordChangeRollback(plan *BatchRollbackPlan) (*BatchRecordChangeUpdateResponse, error) {
+	return c.BatchRecordChangeRollbackWithContext(context.Background(), plan)
+}
+
+// BatchRecordChangeRollbackWithContext submits the inverse batch change of
+// the plan it's passed, using ctx for the lifetime of the request.
+func (c *Client) BatchRecordChangeRollbackWithContext(ctx context.Context, plan *BatchRollbackPlan) (*BatchRecordChangeUpdateResponse, error) {
+	if !plan.HasChanges() {
+		return nil, fmt.Errorf("batch change %s has no applied changes to roll back", plan.Original.ID)
+	}
+
+	return c.BatchRecordChangeCreateWithContext(ctx, plan.Change)
+}
+
+// newBatchRollbackPlan returns the plan that rolls back batch, calling
+// history for the record set change a single change was applied by
+// when the record set's prior data is needed.
+func newBatchRollbackPlan(batch *BatchRecordChange, history func(RecordChange) (*RecordSetChange, error)) (*BatchRollbackPlan, error) {
+	if batch.Status != BatchChangeStatusComplete && batch.Status != BatchChangeStatusPartialFailure {
+		return nil, fmt.Errorf("batch change %s is %s; only applied batch changes can be rolled back", batch.ID, batch.Status)
+	}
+
+	plan := &BatchRollbackPlan{
+		Original: batch,
+		Change: &BatchRecordChange{
+			Comments:     fmt.Sprintf("Rollback of batch change %s", batch.ID),
+			OwnerGroupID: batch.OwnerGroupID,
+			Changes:      []RecordChange{},
+		},
+		Skipped: []RecordChange{},
+	}
+
+	// Changes are grouped by record set, as a record set's prior data
+	// is that before the first of the batch change's changes to it.
+	type recordSetKey struct{ name, recordType string }
+	keys := []recordSetKey{}
+	groups := map[recordSetKey][]RecordChange{}
+	for _, rc := range batch.Changes {
+		if rc.Status != "Complete" {
+			plan.Skipped = append(plan.Skipped, rc)
+			continue
+		}
+		key := recordSetKey{chunkKey(rc), rc.Type}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], rc)
+	}
+
+	for _, key := range keys {
+		changes := groups[key]
+		inverse, err := inverseRecordChanges(changes, history)
+		if err != nil {
+			return nil, fmt.Errorf("%s %s: %w", changes[0].InputName, changes[0].Type, err)
+		}
+		plan.Change.Changes = append(plan.Change.Changes, inverse...)
+	}
+
+	return plan, nil
+}
+
+// inverseRecordChanges returns the changes inverting the applied
+// changes it's passed, all of which are to the same record set.
+func inverseRecordChanges(changes []RecordChange, history func(RecordChange) (*RecordSetChange, error)) ([]RecordChange, error) {
+	adds, deletes := []RecordChange{}, []RecordChange{}
+	deletesRecordSet := false
+	for _, rc := range changes {
+		switch {
+		case rc.ChangeType == ChangeTypeAdd:
+			adds = append(adds, rc)
+		case rc.Record == (RecordData{}):
+			deletesRecordSet = true
+		default:
+			deletes = append(deletes, rc)
+		}
+	}
+
+	var prior *RecordSet
+	if deletesRecordSet || len(deletes) != 0 {
+		change, err := history(changes[0])
+		if err != nil {
+			return nil, fmt.Errorf("retrieving record set change history: %w", err)
+		}
+		prior = priorRecordSet(change)
+	}
+
+	inputName, recordType := changes[0].InputName, changes[0].Type
+	inverse := []RecordChange{}
+	if deletesRecordSet {
+		if len(adds) != 0 {
+			inverse = append(inverse, RecordChange{ChangeType: ChangeTypeDeleteRecordSet, InputName: inputName, Type: recordType})
+		}
+		if prior != nil {
+			for _, r := range prior.Records {
+				inverse = append(inverse, RecordChange{ChangeType: ChangeTypeAdd, InputName: inputName, Type: recordType, TTL: prior.TTL, Record: NewRecordData(r)})
+			}
+		}
+		return inverse, nil
+	}
+
+	for _, rc := range adds {
+		inverse = append(inverse, RecordChange{ChangeType: ChangeTypeDeleteRecordSet, InputName: inputName, Type: recordType, Record: rc.Record})
+	}
+	for _, rc := range deletes {
+		add := RecordChange{ChangeType: ChangeTypeAdd, InputName: inputName, Type: recordType, Record: rc.Record}
+		if prior != nil {
+			add.TTL = prior.TTL
+		}
+		inverse = append(inverse, add)
+	}
+
+	return inverse, nil
+}
+
+// priorRecordSet returns the record set as it was before
+// change, or nil if change created it.
+func priorRecordSet(change *RecordSetChange) *RecordSet {
+	switch change.ChangeType {
+	case "Create":
+		return nil
+	case "Delete":
+		return &change.RecordSet
+	}
+
+	return &change.Updates
+}
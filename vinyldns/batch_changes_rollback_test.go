@@ -0,0 +1,120 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewBatchRollbackPlan(t *testing.T) {
+	batch := &BatchRecordChange{
+		ID:           "batch-id",
+		Status:       BatchChangeStatusPartialFailure,
+		OwnerGroupID: "group-id",
+		Changes: []RecordChange{
+			{ChangeType: ChangeTypeAdd, InputName: "api.ok.", Type: "A", TTL: 300, Record: RecordData{Address: "10.0.0.1"}, Status: "Complete", RecordChangeID: "create-api"},
+			{ChangeType: ChangeTypeDeleteRecordSet, InputName: "old.ok.", Type: "CNAME", Status: "Complete", RecordChangeID: "delete-old"},
+			{ChangeType: ChangeTypeDeleteRecordSet, InputName: "ok.", Type: "MX", Record: RecordData{Preference: 20, Exchange: "mx2.ok."}, Status: "Complete", RecordChangeID: "update-mx"},
+			{ChangeType: ChangeTypeDeleteRecordSet, InputName: "txt.ok.", Type: "TXT", Status: "Complete", RecordChangeID: "update-txt"},
+			{ChangeType: ChangeTypeAdd, InputName: "TXT.ok", Type: "TXT", TTL: 60, Record: RecordData{Text: "new"}, Status: "Complete", RecordChangeID: "update-txt"},
+			{ChangeType: ChangeTypeAdd, InputName: "failed.ok.", Type: "A", Record: RecordData{Address: "10.0.0.2"}, Status: "Failed"},
+		},
+	}
+	history := map[string]*RecordSetChange{
+		"delete-old": {ChangeType: "Delete", RecordSet: RecordSet{TTL: 3600, Records: []Record{NewCNAMERecord("api.ok.")}}},
+		"update-mx": {
+			ChangeType: "Update",
+			RecordSet:  RecordSet{TTL: 300, Records: []Record{NewMXRecord(10, "mx1.ok.")}},
+			Updates:    RecordSet{TTL: 600, Records: []Record{NewMXRecord(10, "mx1.ok."), NewMXRecord(20, "mx2.ok.")}},
+		},
+		"update-txt": {
+			ChangeType: "Update",
+			RecordSet:  RecordSet{TTL: 60, Records: []Record{NewTXTRecord("new")}},
+			Updates:    RecordSet{TTL: 120, Records: []Record{NewTXTRecord("old1"), NewTXTRecord("old2")}},
+		},
+	}
+	requested := []string{}
+
+	plan, err := newBatchRollbackPlan(batch, func(rc RecordChange) (*RecordSetChange, error) {
+		requested = append(requested, rc.RecordChangeID)
+		return history[rc.RecordChangeID], nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []RecordChange{
+		{ChangeType: ChangeTypeDeleteRecordSet, InputName: "api.ok.", Type: "A", Record: RecordData{Address: "10.0.0.1"}},
+		{ChangeType: ChangeTypeAdd, InputName: "old.ok.", Type: "CNAME", TTL: 3600, Record: RecordData{CName: "api.ok."}},
+		{ChangeType: ChangeTypeAdd, InputName: "ok.", Type: "MX", TTL: 600, Record: RecordData{Preference: 20, Exchange: "mx2.ok."}},
+		{ChangeType: ChangeTypeDeleteRecordSet, InputName: "txt.ok.", Type: "TXT"},
+		{ChangeType: ChangeTypeAdd, InputName: "txt.ok.", Type: "TXT", TTL: 120, Record: RecordData{Text: "old1"}},
+		{ChangeType: ChangeTypeAdd, InputName: "txt.ok.", Type: "TXT", TTL: 120, Record: RecordData{Text: "old2"}},
+	}
+	if !reflect.DeepEqual(plan.Change.Changes, expected) {
+		t.Errorf("Expected inverse changes:\n%+v\ngot:\n%+v", expected, plan.Change.Changes)
+	}
+	if plan.Change.OwnerGroupID != "group-id" || plan.Change.Comments != "Rollback of batch change batch-id" {
+		t.Errorf("Expected the rollback to carry the owner group and a comment; got %+v", plan.Change)
+	}
+	if len(plan.Skipped) != 1 || plan.Skipped[0].InputName != "failed.ok." {
+		t.Errorf("Expected the failed change to be skipped; got %+v", plan.Skipped)
+	}
+	if !reflect.DeepEqual(requested, []string{"delete-old", "update-mx", "update-txt"}) {
+		t.Errorf("Expected history to be retrieved only for deletes; got %v", requested)
+	}
+
+	expectedString := `Rollback of batch change batch-id:
+  - api.ok. A 10.0.0.1
+  + old.ok. CNAME ttl=3600 api.ok.
+  + ok. MX ttl=600 20 mx2.ok.
+  - txt.ok. TXT (record set)
+  + txt.ok. TXT ttl=120 "old1"
+  + txt.ok. TXT ttl=120 "old2"
+  skipped Add failed.ok. A (Failed)
+4 to add, 2 to delete, 1 skipped.
+`
+	if plan.String() != expectedString {
+		t.Errorf("Expected plan:\n%s\ngot:\n%s", expectedString, plan.String())
+	}
+}
+
+func TestNewBatchRollbackPlanErrors(t *testing.T) {
+	noHistory := func(rc RecordChange) (*RecordSetChange, error) {
+		return nil, errors.New("no history")
+	}
+
+	_, err := newBatchRollbackPlan(&BatchRecordChange{ID: "batch-id", Status: BatchChangeStatusPendingReview}, noHistory)
+	if err == nil || !strings.Contains(err.Error(), "only applied batch changes") {
+		t.Errorf("Expected an error for a batch change pending review; got %v", err)
+	}
+
+	_, err = newBatchRollbackPlan(&BatchRecordChange{
+		Status:  BatchChangeStatusComplete,
+		Changes: []RecordChange{{ChangeType: ChangeTypeDeleteRecordSet, InputName: "old.ok.", Type: "A", Status: "Complete"}},
+	}, noHistory)
+	if err == nil || !strings.Contains(err.Error(), "old.ok. A: retrieving record set change history: no history") {
+		t.Errorf("Expected the history error; got %v", err)
+	}
+
+	plan, err := newBatchRollbackPlan(&BatchRecordChange{
+		Status:  BatchChangeStatusComplete,
+		Changes: []RecordChange{{ChangeType: ChangeTypeAdd, InputName: "new.ok.", Type: "A", Record: RecordData{Address: "10.0.0.1"}, Status: "Complete"}},
+	}, noHistory)
+	if err != nil || !plan.HasChanges() {
+		t.Errorf("Expected an Add to be rolled back without history; got %v", err)
+	}
+}
@@ -14,8 +14,11 @@ package vinyldns
 
 import (
 	"encoding/json"
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/gobs/pretty"
 )
@@ -50,6 +53,48 @@ func TestBatchRecordChanges(t *testing.T) {
 	}
 }
 
+func TestBatchRecordChangesListAll(t *testing.T) {
+	page1, err := readFile("test-fixtures/batch-changes/batch-changes-list-1.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	page2, err := readFile("test-fixtures/batch-changes/batch-changes-list-2.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges?approvalStatus=PendingReview&maxItems=1",
+			code:     200,
+			body:     page1,
+		},
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges?approvalStatus=PendingReview&maxItems=1&startFrom=1",
+			code:     200,
+			body:     page2,
+		},
+	})
+
+	defer server.Close()
+
+	summaries, err := client.BatchRecordChangesListAll(BatchRecordChangesFilter{
+		ApprovalStatus: ApprovalStatusPendingReview,
+		MaxItems:       1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summaries) != 2 {
+		t.Fatalf("Expected 2 batch change summaries; got %d", len(summaries))
+	}
+	if summaries[0].OwnerGroupName != "ok-group" || summaries[0].TotalChanges != 2 {
+		t.Errorf("Expected the first summary to be of 2 changes owned by ok-group; got %+v", summaries[0])
+	}
+	if summaries[1].ScheduledTime != "2018-05-12T00:00:00Z" {
+		t.Errorf("Expected the second summary to be scheduled; got %+v", summaries[1])
+	}
+}
+
 func TestBatchRecordChangeEncoding(t *testing.T) {
 	recordChangeJSON, err := readFile("test-fixtures/batch-changes/batch-recordchange-format.json")
 	if err != nil {
@@ -152,3 +197,181 @@ func TestBatchRecordChangeCreate(t *testing.T) {
 		t.Error("Expected BatchRecordChangeCreate.Comments to be 'this is optional'")
 	}
 }
+
+func TestBatchRecordChangeCreateValidationError(t *testing.T) {
+	invalidJSON, err := readFile("test-fixtures/batch-changes/batch-change-invalid.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges",
+			code:     400,
+			body:     invalidJSON,
+		},
+	})
+	defer server.Close()
+
+	change := &BatchRecordChange{
+		Changes: []RecordChange{
+			{ChangeType: ChangeTypeAdd, InputName: "api.ok", Type: "A", TTL: 300, Record: RecordData{Address: "10.0.0.1"}},
+			{ChangeType: ChangeTypeAdd, InputName: "alias.ok", Type: "CNAME", TTL: 300, Record: RecordData{CName: "api.ok."}},
+		},
+	}
+	_, err = client.BatchRecordChangeCreate(change)
+
+	var validationErr *BatchChangeValidationError
+	if !errors.As(err, &validationErr) {
+		t.Fatalf("Expected a *BatchChangeValidationError; got %v", err)
+	}
+	if len(validationErr.Changes) != 2 || len(validationErr.Changes[0].Errors) != 0 {
+		t.Fatalf("Expected 2 changes, the first valid; got %+v", validationErr.Changes)
+	}
+	invalid := validationErr.Invalid()
+	if len(invalid) != 1 || invalid[0].Index != 1 || len(invalid[0].Errors) != 2 {
+		t.Fatalf("Expected the second change to have 2 errors; got %+v", invalid)
+	}
+	if invalid[0].Change != change.Changes[1] {
+		t.Errorf("Expected the submitted change; got %+v", invalid[0].Change)
+	}
+	if !strings.Contains(err.Error(), "1 of 2 changes rejected: change 1 (Add alias.ok CNAME): CNAME Conflict") {
+		t.Errorf("Expected the error to describe the rejected change; got %s", err)
+	}
+
+	var vErr *Error
+	if !errors.As(err, &vErr) || vErr.ResponseCode != 400 {
+		t.Errorf("Expected the error to wrap the 400 *Error; got %v", err)
+	}
+}
+
+func TestBatchRecordChangeCreateBadRequest(t *testing.T) {
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges",
+			code:     400,
+			body:     `"Batch change contained no changes."`,
+		},
+	})
+	defer server.Close()
+
+	_, err := client.BatchRecordChangeCreate(&BatchRecordChange{})
+
+	var validationErr *BatchChangeValidationError
+	if errors.As(err, &validationErr) {
+		t.Errorf("Expected a plain *Error for a message response; got %v", err)
+	}
+	var vErr *Error
+	if !errors.As(err, &vErr) || vErr.Message != "Batch change contained no changes." {
+		t.Errorf("Expected an *Error; got %v", err)
+	}
+}
+
+func TestBatchRecordChangeSubmit(t *testing.T) {
+	createJSON, err := readFile("test-fixtures/batch-changes/batch-change-create.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges?allowManualReview=false",
+			code:     202,
+			body:     createJSON,
+		},
+	})
+	defer server.Close()
+
+	allow := false
+	change := &BatchRecordChange{Changes: []RecordChange{{ChangeType: ChangeTypeAdd, InputName: "example.com.", Type: "A", Record: RecordData{Address: "127.0.0.1"}}}}
+	resp, err := client.BatchRecordChangeSubmit(change, &BatchChangeCreateOptions{
+		AllowManualReview: &allow,
+		ScheduledTime:     time.Date(2026, 10, 18, 8, 0, 0, 0, time.FixedZone("EDT", -4*60*60)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Comments != "this is optional" {
+		t.Errorf("Expected the created batch change; got %+v", resp)
+	}
+	if change.ScheduledTime != "" {
+		t.Errorf("Expected the change passed to be unmodified; got ScheduledTime %q", change.ScheduledTime)
+	}
+}
+
+func TestBatchRecordChangesScheduled(t *testing.T) {
+	page1, err := readFile("test-fixtures/batch-changes/batch-changes-list-1.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	page2, err := readFile("test-fixtures/batch-changes/batch-changes-list-2.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges?approvalStatus=PendingReview&maxItems=1",
+			code:     200,
+			body:     page1,
+		},
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges?approvalStatus=PendingReview&maxItems=1&startFrom=1",
+			code:     200,
+			body:     page2,
+		},
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges?approvalStatus=PendingReview&dateTimeRangeStart=2018-05-11T18%3A12%3A13Z&maxItems=1",
+			code:     200,
+			body:     page1,
+		},
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges?approvalStatus=PendingReview&dateTimeRangeStart=2018-05-11T18%3A12%3A13Z&maxItems=1&startFrom=1",
+			code:     200,
+			body:     `{"batchChanges":[{"id":"older","createdTimestamp":"2018-05-11T18:12:12Z","status":"Scheduled","scheduledTime":"2018-05-13T00:00:00Z"}],"nextId":2,"maxItems":1}`,
+		},
+		{
+			endpoint: "http://host.com/zones/batchrecordchanges?approvalStatus=PendingReview&dateTimeRangeStart=2018-05-11T18%3A12%3A13Z&maxItems=1&startFrom=2",
+			code:     200,
+			body:     page2,
+		},
+	})
+
+	defer server.Close()
+
+	scheduled, err := client.BatchRecordChangesScheduled(BatchRecordChangesFilter{MaxItems: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scheduled) != 1 || scheduled[0].Comments != "scheduled" {
+		t.Errorf("Expected only the scheduled batch change; got %+v", scheduled)
+	}
+
+	// Batch changes created out of order don't stop paging, and the scheduled
+	// time bound is applied apart from the creation time range.
+	scheduled, err = client.BatchRecordChangesScheduled(BatchRecordChangesFilter{
+		MaxItems:           1,
+		DateTimeRangeStart: time.Date(2018, 5, 11, 18, 12, 13, 0, time.UTC),
+		ScheduledTimeStart: time.Date(2018, 5, 12, 12, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scheduled) != 1 || scheduled[0].ID != "older" {
+		t.Errorf("Expected only the batch change scheduled after the scheduled time start; got %+v", scheduled)
+	}
+
+	scheduled, err = client.BatchRecordChangesScheduled(BatchRecordChangesFilter{ApprovalStatus: ApprovalStatusAutoApproved})
+	if err != nil || len(scheduled) != 0 {
+		t.Errorf("Expected no scheduled batch changes that were auto-approved; got %+v, %v", scheduled, err)
+	}
+}
+
+func TestBatchRecordChangeScheduledAt(t *testing.T) {
+	change := &BatchRecordChange{ScheduledTime: "2026-10-18T12:00:00Z"}
+	at, ok := change.ScheduledAt()
+	if !ok || !at.Equal(time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 2026-10-18T12:00:00Z; got %v %t", at, ok)
+	}
+
+	if _, ok := (BatchChangeSummary{}).ScheduledAt(); ok {
+		t.Error("Expected an unscheduled batch change not to have a scheduled time")
+	}
+}
@@ -13,21 +13,36 @@ limitations under the License.
 package vinyldns
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"os"
+	"time"
 )
 
 // ClientConfiguration represents the vinyldns client configuration.
 type ClientConfiguration struct {
-	AccessKey string
-	SecretKey string
-	Host      string
-	UserAgent string
+	AccessKey   string
+	SecretKey   string
+	Host        string
+	UserAgent   string
+	RetryPolicy *RetryPolicy
+
+	// Timeout limits the time taken by each HTTP request. Zero means no timeout.
+	Timeout time.Duration
+
+	// TLSConfig configures the TLS connections made to Host.
+	// If nil, the default configuration is used.
+	TLSConfig *tls.Config
+
+	// Credentials provides the credentials each request is signed with.
+	// If nil, requests are signed with AccessKey and SecretKey.
+	Credentials CredentialsProvider
 }
 
 // NewConfigFromEnv creates a new ClientConfiguration
-// using environment variables.
+// using environment variables. See LoadConfig to also
+// read a profile from the config file.
 func NewConfigFromEnv() ClientConfiguration {
 	ua := defaultUA()
 
@@ -35,10 +50,10 @@ func NewConfigFromEnv() ClientConfiguration {
 		ua = vua
 	}
 	return ClientConfiguration{
-		os.Getenv("VINYLDNS_ACCESS_KEY"),
-		os.Getenv("VINYLDNS_SECRET_KEY"),
-		os.Getenv("VINYLDNS_HOST"),
-		ua,
+		AccessKey: os.Getenv("VINYLDNS_ACCESS_KEY"),
+		SecretKey: os.Getenv("VINYLDNS_SECRET_KEY"),
+		Host:      os.Getenv("VINYLDNS_HOST"),
+		UserAgent: ua,
 	}
 }
 
@@ -49,6 +64,15 @@ type Client struct {
 	Host       string
 	HTTPClient *http.Client
 	UserAgent  string
+
+	// RetryPolicy governs retries of transient failures.
+	// A nil RetryPolicy makes a single attempt per request.
+	RetryPolicy *RetryPolicy
+
+	// Credentials provides the credentials each request is signed with,
+	// and is consulted for every request so that they may be rotated.
+	// If nil, requests are signed with AccessKey and SecretKey.
+	Credentials CredentialsProvider
 }
 
 // NewClientFromEnv returns a Client configured via
@@ -65,14 +89,28 @@ func NewClient(config ClientConfiguration) *Client {
 	}
 
 	return &Client{
-		config.AccessKey,
-		config.SecretKey,
-		config.Host,
-		&http.Client{},
-		config.UserAgent,
+		AccessKey:   config.AccessKey,
+		SecretKey:   config.SecretKey,
+		Host:        config.Host,
+		HTTPClient:  newHTTPClient(config),
+		UserAgent:   config.UserAgent,
+		RetryPolicy: config.RetryPolicy,
+		Credentials: config.Credentials,
 	}
 }
 
+// newHTTPClient returns the HTTP client for the ClientConfiguration it's passed.
+func newHTTPClient(config ClientConfiguration) *http.Client {
+	client := &http.Client{Timeout: config.Timeout}
+	if config.TLSConfig != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = config.TLSConfig
+		client.Transport = transport
+	}
+
+	return client
+}
+
 func defaultUA() string {
 	return fmt.Sprintf("go-vinyldns/%s", Version)
 }
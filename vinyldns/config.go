@@ -0,0 +1,253 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultProfile is the config file profile used when VINYLDNS_PROFILE is unset.
+const DefaultProfile = "default"
+
+// configKeys are the keys a config file profile may set. Each is
+// overridden by the environment variable VINYLDNS_<KEY>, upper-cased.
+var configKeys = []string{
+	"host",
+	"access_key",
+	"secret_key",
+	"user_agent",
+	"timeout",
+	"tls_ca_file",
+	"tls_insecure_skip_verify",
+}
+
+// ConfigFilePath returns the path of the config file: VINYLDNS_CONFIG_FILE
+// if it's set, and ~/.vinyldns/config otherwise.
+func ConfigFilePath() (string, error) {
+	if path := os.Getenv("VINYLDNS_CONFIG_FILE"); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".vinyldns", "config"), nil
+}
+
+// LoadConfig returns the ClientConfiguration built from, in increasing
+// order of precedence:
+//
+//  1. the defaults, such as the go-vinyldns user agent;
+//  2. the profile named by VINYLDNS_PROFILE, or the "default" profile,
+//     of the config file at ConfigFilePath, if it exists;
+//  3. the VINYLDNS_HOST, VINYLDNS_ACCESS_KEY, VINYLDNS_SECRET_KEY,
+//     VINYLDNS_USER_AGENT, VINYLDNS_TIMEOUT, VINYLDNS_TLS_CA_FILE and
+//     VINYLDNS_TLS_INSECURE_SKIP_VERIFY environment variables.
+//
+// The config file is INI formatted, with a section per profile:
+//
+//	[default]
+//	host = https://vinyldns.example.com
+//	access_key = ...
+//	secret_key = ...
+//
+//	[profile staging]
+//	host = https://vinyldns.staging.example.com
+//	timeout = 30s
+//	tls_ca_file = /etc/ssl/staging-ca.pem
+//
+// It's an error for VINYLDNS_PROFILE to name a profile that doesn't exist.
+func LoadConfig() (ClientConfiguration, error) {
+	return LoadConfigProfile(os.Getenv("VINYLDNS_PROFILE"))
+}
+
+// LoadConfigProfile is like LoadConfig, but uses the config file profile it's
+// passed, or the "default" profile if it's passed "". Only the "default"
+// profile may be absent.
+func LoadConfigProfile(profile string) (ClientConfiguration, error) {
+	values := map[string]string{}
+
+	// Without a home directory there's no default config file to read.
+	var profiles map[string]map[string]string
+	path, err := ConfigFilePath()
+	if err == nil {
+		profiles, err = readConfigFile(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return ClientConfiguration{}, err
+		}
+	}
+
+	name := profile
+	if name == "" {
+		name = DefaultProfile
+	}
+	if p, ok := profiles[name]; ok {
+		values = p
+	} else if name != DefaultProfile {
+		return ClientConfiguration{}, fmt.Errorf("profile %q not found in config file %s", name, path)
+	}
+
+	for _, key := range configKeys {
+		if v, ok := os.LookupEnv("VINYLDNS_" + strings.ToUpper(key)); ok && v != "" {
+			values[key] = v
+		}
+	}
+
+	config, err := configFromValues(values)
+	if err != nil {
+		return ClientConfiguration{}, fmt.Errorf("profile %q: %w", name, err)
+	}
+
+	return config, nil
+}
+
+// readConfigFile reads the profiles of the config file at path.
+func readConfigFile(path string) (map[string]map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	profiles, err := parseConfig(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return profiles, nil
+}
+
+// parseConfig parses an INI config file into its profiles' values. Sections
+// may be named "[name]" or "[profile name]"; comments start with # or ;.
+func parseConfig(r io.Reader) (map[string]map[string]string, error) {
+	return parseProfiles(r, true)
+}
+
+// parseProfiles parses an INI config file as parseConfig does. If strict,
+// keys other than configKeys are an error; otherwise, they're ignored.
+func parseProfiles(r io.Reader, strict bool) (map[string]map[string]string, error) {
+	profiles := map[string]map[string]string{}
+	var current map[string]string
+
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			if !strings.HasSuffix(line, "]") {
+				return nil, fmt.Errorf("line %d: malformed section %s", lineNo, line)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			name = strings.TrimSpace(strings.TrimPrefix(name, "profile "))
+			if name == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNo)
+			}
+			if _, ok := profiles[name]; !ok {
+				profiles[name] = map[string]string{}
+			}
+			current = profiles[name]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value", lineNo)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: %s is outside of a profile", lineNo, strings.TrimSpace(key))
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !contains(configKeys, key) {
+			if !strict {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: unknown key %s", lineNo, key)
+		}
+		current[key] = strings.Trim(strings.TrimSpace(value), `"`)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// configFromValues returns the ClientConfiguration holding the config values it's passed.
+func configFromValues(values map[string]string) (ClientConfiguration, error) {
+	config := ClientConfiguration{
+		Host:      values["host"],
+		AccessKey: values["access_key"],
+		SecretKey: values["secret_key"],
+		UserAgent: values["user_agent"],
+	}
+	if config.UserAgent == "" {
+		config.UserAgent = defaultUA()
+	}
+
+	// A timeout is a duration such as 30s, or a number of seconds.
+	if v := values["timeout"]; v != "" {
+		if isDigits(v) {
+			v += "s"
+		}
+		timeout, err := time.ParseDuration(v)
+		if err != nil {
+			return ClientConfiguration{}, fmt.Errorf("invalid timeout %q", values["timeout"])
+		}
+		config.Timeout = timeout
+	}
+
+	insecure := false
+	if v := values["tls_insecure_skip_verify"]; v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			return ClientConfiguration{}, fmt.Errorf("invalid tls_insecure_skip_verify %q", v)
+		}
+		insecure = b
+	}
+
+	caFile := values["tls_ca_file"]
+	if caFile == "" && !insecure {
+		return config, nil
+	}
+
+	config.TLSConfig = &tls.Config{InsecureSkipVerify: insecure}
+	if caFile != "" {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return ClientConfiguration{}, fmt.Errorf("reading tls_ca_file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return ClientConfiguration{}, fmt.Errorf("tls_ca_file %s holds no PEM certificates", caFile)
+		}
+		config.TLSConfig.RootCAs = pool
+	}
+
+	return config, nil
+}
@@ -15,7 +15,9 @@ package vinyldns
 import (
 	"fmt"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestNewConfigFromEnv(t *testing.T) {
@@ -62,6 +64,90 @@ func TestNewClient(t *testing.T) {
 	expectSame(t, client.UserAgent, testUserAgent, "client.UserAgent")
 }
 
+func writeTestConfig(t *testing.T, content string) {
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("VINYLDNS_CONFIG_FILE", path)
+	for _, key := range []string{"PROFILE", "HOST", "ACCESS_KEY", "SECRET_KEY", "USER_AGENT", "TIMEOUT", "TLS_CA_FILE", "TLS_INSECURE_SKIP_VERIFY"} {
+		t.Setenv("VINYLDNS_"+key, "")
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	writeTestConfig(t, `# profiles
+[default]
+host = https://vinyldns.example.com
+access_key = default-access
+secret_key = default-secret
+
+[profile staging]
+host = https://vinyldns.staging.example.com
+access_key = staging-access
+secret_key = "staging-secret"
+timeout = 30
+tls_insecure_skip_verify = true
+`)
+
+	config, err := LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, config.Host, "https://vinyldns.example.com", "config.Host")
+	expectSame(t, config.AccessKey, "default-access", "config.AccessKey")
+	expectSame(t, config.UserAgent, fmt.Sprintf("go-vinyldns/%s", Version), "config.UserAgent")
+
+	t.Setenv("VINYLDNS_PROFILE", "staging")
+	t.Setenv("VINYLDNS_SECRET_KEY", "env-secret")
+	config, err = LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, config.Host, "https://vinyldns.staging.example.com", "config.Host")
+	expectSame(t, config.AccessKey, "staging-access", "config.AccessKey")
+	expectSame(t, config.SecretKey, "env-secret", "config.SecretKey")
+	expectSame(t, config.Timeout, 30*time.Second, "config.Timeout")
+	if config.TLSConfig == nil || !config.TLSConfig.InsecureSkipVerify {
+		t.Errorf("Expected TLS verification to be skipped; got %+v", config.TLSConfig)
+	}
+
+	client := NewClient(config)
+	expectSame(t, client.HTTPClient.Timeout, 30*time.Second, "client.HTTPClient.Timeout")
+
+	t.Setenv("VINYLDNS_PROFILE", "missing")
+	if _, err := LoadConfig(); err == nil {
+		t.Error("Expected an error loading a missing profile")
+	}
+}
+
+func TestLoadConfigWithoutFile(t *testing.T) {
+	writeTestConfig(t, "")
+	t.Setenv("VINYLDNS_CONFIG_FILE", filepath.Join(t.TempDir(), "missing"))
+	t.Setenv("VINYLDNS_HOST", "env.example.com")
+
+	config, err := LoadConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, config.Host, "env.example.com", "config.Host")
+}
+
+func TestLoadConfigInvalid(t *testing.T) {
+	for _, content := range []string{
+		"host = outside.example.com\n",
+		"[default]\nhost\n",
+		"[default]\nregion = us-east-1\n",
+		"[default]\ntimeout = soon\n",
+		"[default\n",
+	} {
+		writeTestConfig(t, content)
+		if _, err := LoadConfig(); err == nil {
+			t.Errorf("Expected an error loading %q", content)
+		}
+	}
+}
+
 func envOrDefault(envVar, defaultValue string) string {
 	if value, has := os.LookupEnv(envVar); has {
 		return value
@@ -0,0 +1,187 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrNoCredentials is returned by a CredentialsProvider that has no credentials to provide.
+var ErrNoCredentials = errors.New("vinyldns: no credentials")
+
+// Credentials are the keys a request is signed with.
+type Credentials struct {
+	AccessKey string
+	SecretKey string
+}
+
+// CredentialsProvider provides the credentials a Client signs requests with.
+// A Client retrieves credentials for every request, so a provider can rotate
+// them without the client being recreated. Retrieve may be called concurrently.
+type CredentialsProvider interface {
+	Retrieve(ctx context.Context) (Credentials, error)
+}
+
+// StaticCredentialsProvider provides fixed credentials.
+type StaticCredentialsProvider struct {
+	Credentials Credentials
+}
+
+// NewStaticCredentialsProvider returns a StaticCredentialsProvider of the keys it's passed.
+func NewStaticCredentialsProvider(accessKey, secretKey string) *StaticCredentialsProvider {
+	return &StaticCredentialsProvider{Credentials: Credentials{AccessKey: accessKey, SecretKey: secretKey}}
+}
+
+// Retrieve returns the provider's credentials, or ErrNoCredentials if its keys are empty.
+func (p *StaticCredentialsProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	if p.Credentials.AccessKey == "" || p.Credentials.SecretKey == "" {
+		return Credentials{}, ErrNoCredentials
+	}
+
+	return p.Credentials, nil
+}
+
+// EnvCredentialsProvider provides the credentials held by the VINYLDNS_ACCESS_KEY
+// and VINYLDNS_SECRET_KEY environment variables when each request is made.
+type EnvCredentialsProvider struct{}
+
+// Retrieve returns the credentials held by the environment,
+// or ErrNoCredentials if either variable is unset or empty.
+func (EnvCredentialsProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	creds := Credentials{
+		AccessKey: os.Getenv("VINYLDNS_ACCESS_KEY"),
+		SecretKey: os.Getenv("VINYLDNS_SECRET_KEY"),
+	}
+	if creds.AccessKey == "" || creds.SecretKey == "" {
+		return Credentials{}, fmt.Errorf("%w: VINYLDNS_ACCESS_KEY and VINYLDNS_SECRET_KEY must be set", ErrNoCredentials)
+	}
+
+	return creds, nil
+}
+
+// fileCredentialsRacyWindow is how long after a credentials file's modification
+// its cached credentials aren't trusted, as the file may be rewritten within the
+// modification time's granularity without changing its modification time or size.
+const fileCredentialsRacyWindow = 2 * time.Second
+
+// FileCredentialsProvider provides the access_key and secret_key of a profile of
+// a file in the config file format described by LoadConfig, such as one written by
+// a secrets manager. Keys other than those of the config file format are ignored.
+// The file's credentials are cached, and the file is re-read only when its
+// modification time or size changes, or while it was modified too recently for
+// them to reveal a change, so rotated keys are used by the next request.
+type FileCredentialsProvider struct {
+	path    string
+	profile string
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	readAt  time.Time
+	creds   Credentials
+}
+
+// NewFileCredentialsProvider returns a FileCredentialsProvider of the profile,
+// or the "default" profile if it's passed "", of the file at path.
+func NewFileCredentialsProvider(path, profile string) *FileCredentialsProvider {
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	return &FileCredentialsProvider{path: path, profile: profile}
+}
+
+// Retrieve returns the credentials held by the file, re-reading it if it may have changed.
+func (p *FileCredentialsProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	info, err := os.Stat(p.path)
+	if err != nil {
+		return Credentials{}, err
+	}
+	if p.creds.AccessKey != "" && info.ModTime().Equal(p.modTime) && info.Size() == p.size &&
+		p.readAt.Sub(p.modTime) > fileCredentialsRacyWindow {
+		return p.creds, nil
+	}
+
+	readAt := time.Now()
+	content, err := os.ReadFile(p.path)
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	profiles, err := parseProfiles(bytes.NewReader(content), false)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("%s: %w", p.path, err)
+	}
+	values, ok := profiles[p.profile]
+	if !ok {
+		return Credentials{}, fmt.Errorf("%w: profile %q not found in %s", ErrNoCredentials, p.profile, p.path)
+	}
+	creds := Credentials{AccessKey: values["access_key"], SecretKey: values["secret_key"]}
+	if creds.AccessKey == "" || creds.SecretKey == "" {
+		return Credentials{}, fmt.Errorf("%w: profile %q of %s must set access_key and secret_key", ErrNoCredentials, p.profile, p.path)
+	}
+
+	p.creds, p.modTime, p.size, p.readAt = creds, info.ModTime(), info.Size(), readAt
+
+	return creds, nil
+}
+
+// ChainCredentialsProvider provides the credentials of the
+// first of its providers that has credentials to provide.
+type ChainCredentialsProvider struct {
+	Providers []CredentialsProvider
+}
+
+// NewChainCredentialsProvider returns a ChainCredentialsProvider of the providers it's passed.
+func NewChainCredentialsProvider(providers ...CredentialsProvider) *ChainCredentialsProvider {
+	return &ChainCredentialsProvider{Providers: providers}
+}
+
+// Retrieve returns the credentials of the first provider to return credentials
+// without error, or all of the providers' errors if none does.
+func (p *ChainCredentialsProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	errs := []error{ErrNoCredentials}
+	for _, provider := range p.Providers {
+		creds, err := provider.Retrieve(ctx)
+		if err == nil {
+			return creds, nil
+		}
+		errs = append(errs, err)
+	}
+
+	return Credentials{}, errors.Join(errs...)
+}
+
+// credentials returns the credentials to sign a request with: those of the
+// client's Credentials provider, if it has one, or its AccessKey and SecretKey.
+func (c *Client) credentials(ctx context.Context) (Credentials, error) {
+	if c.Credentials == nil {
+		return Credentials{AccessKey: c.AccessKey, SecretKey: c.SecretKey}, nil
+	}
+
+	creds, err := c.Credentials.Retrieve(ctx)
+	if err != nil {
+		return Credentials{}, fmt.Errorf("retrieving credentials: %w", err)
+	}
+
+	return creds, nil
+}
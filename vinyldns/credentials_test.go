@@ -0,0 +1,180 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEnvCredentialsProvider(t *testing.T) {
+	t.Setenv("VINYLDNS_ACCESS_KEY", "")
+	t.Setenv("VINYLDNS_SECRET_KEY", "")
+
+	p := EnvCredentialsProvider{}
+	if _, err := p.Retrieve(context.Background()); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Expected ErrNoCredentials; got %v", err)
+	}
+
+	t.Setenv("VINYLDNS_ACCESS_KEY", "env-access")
+	t.Setenv("VINYLDNS_SECRET_KEY", "env-secret")
+	creds, err := p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, creds.AccessKey, "env-access", "creds.AccessKey")
+	expectSame(t, creds.SecretKey, "env-secret", "creds.SecretKey")
+}
+
+func TestFileCredentialsProvider(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "credentials")
+	write := func(content string, modTime time.Time) {
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	p := NewFileCredentialsProvider(path, "")
+
+	if _, err := p.Retrieve(context.Background()); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Expected a not exist error; got %v", err)
+	}
+
+	now := time.Now()
+	write("[default]\naccess_key = first-access\nsecret_key = first-secret\n", now.Add(-time.Minute))
+	creds, err := p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, creds.AccessKey, "first-access", "creds.AccessKey")
+
+	// The credentials are cached while the file's modification time and size
+	// are unchanged, once it was last modified well before it was read.
+	write("[default]\naccess_key = other-access\nsecret_key = other-secret\n", now.Add(-time.Minute))
+	creds, err = p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, creds.AccessKey, "first-access", "cached creds.AccessKey")
+
+	write("[default]\naccess_key = second-access\nsecret_key = second-secret\n", now)
+	creds, err = p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, creds.AccessKey, "second-access", "rotated creds.AccessKey")
+	expectSame(t, creds.SecretKey, "second-secret", "rotated creds.SecretKey")
+
+	// A rotation to keys of the same length within the modification time's
+	// granularity leaves the file's modification time and size unchanged.
+	write("[default]\naccess_key = thirds-access\nsecret_key = thirds-secret\n", now)
+	creds, err = p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, creds.AccessKey, "thirds-access", "rotated creds.AccessKey of the same length")
+
+	write("[default]\naccess_key = fourth-access\nsecret_key = fourth-secret\nrotated_at = 2026-10-18\n\n[metadata]\nversion = 4\n", now)
+	creds, err = p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, creds.AccessKey, "fourth-access", "creds.AccessKey alongside unknown keys")
+
+	write("[default]\naccess_key = second-access\n", now.Add(time.Minute))
+	if _, err := p.Retrieve(context.Background()); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Expected ErrNoCredentials; got %v", err)
+	}
+}
+
+func TestChainCredentialsProvider(t *testing.T) {
+	t.Setenv("VINYLDNS_ACCESS_KEY", "")
+	t.Setenv("VINYLDNS_SECRET_KEY", "")
+
+	p := NewChainCredentialsProvider(
+		EnvCredentialsProvider{},
+		NewFileCredentialsProvider(filepath.Join(t.TempDir(), "missing"), ""),
+		NewStaticCredentialsProvider("static-access", "static-secret"),
+	)
+	creds, err := p.Retrieve(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectSame(t, creds.AccessKey, "static-access", "creds.AccessKey")
+
+	p = NewChainCredentialsProvider(EnvCredentialsProvider{}, NewStaticCredentialsProvider("", ""))
+	if _, err := p.Retrieve(context.Background()); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Expected ErrNoCredentials; got %v", err)
+	}
+}
+
+type rotatingProvider struct {
+	keys []string
+	err  error
+}
+
+func (p *rotatingProvider) Retrieve(ctx context.Context) (Credentials, error) {
+	if p.err != nil {
+		return Credentials{}, p.err
+	}
+	key := p.keys[0]
+	p.keys = p.keys[1:]
+
+	return Credentials{AccessKey: key, SecretKey: key + "-secret"}, nil
+}
+
+func TestClientCredentialsProvider(t *testing.T) {
+	var signedWith []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		_, cred, _ := strings.Cut(auth, "Credential=")
+		key, _, _ := strings.Cut(cred, "/")
+		signedWith = append(signedWith, key)
+		w.Write([]byte(`{"zones":[]}`))
+	}))
+	defer ts.Close()
+
+	provider := &rotatingProvider{keys: []string{"first", "second"}}
+	c := NewClient(ClientConfiguration{
+		Host:        ts.URL,
+		AccessKey:   "unused",
+		SecretKey:   "unused",
+		Credentials: provider,
+	})
+
+	for i := 0; i < 2; i++ {
+		if _, err := c.Zones(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if strings.Join(signedWith, ",") != "first,second" {
+		t.Errorf("Expected requests signed with first,second; got %v", signedWith)
+	}
+
+	provider.err = errors.New("secrets manager unavailable")
+	if _, err := c.Zones(); err == nil || !strings.Contains(err.Error(), "secrets manager unavailable") {
+		t.Errorf("Expected the provider's error; got %v", err)
+	}
+	if len(signedWith) != 2 {
+		t.Errorf("Expected no request without credentials; got %d requests", len(signedWith))
+	}
+}
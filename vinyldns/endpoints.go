@@ -13,366 +13,334 @@ limitations under the License.
 package vinyldns
 
 import (
-	"fmt"
-	"strings"
+	"net/url"
+	"strconv"
+	"time"
 )
 
+// endpoint returns the URL of the API path made of the segments it's passed,
+// each escaped, followed by the query, if it has any params.
+func endpoint(c *Client, q query, segments ...string) string {
+	u := c.Host
+	for _, s := range segments {
+		u += "/" + url.PathEscape(s)
+	}
+
+	return u + q.encode()
+}
+
+// query holds the params of an endpoint's query string. Its setters skip
+// zero values, which the API treats as unset, so that a filter's params can
+// be set without checking each of them.
+type query url.Values
+
+func (q query) set(key, value string) query {
+	if value != "" {
+		url.Values(q).Set(key, value)
+	}
+
+	return q
+}
+
+func (q query) add(key, value string) query {
+	if value != "" {
+		url.Values(q).Add(key, value)
+	}
+
+	return q
+}
+
+func (q query) setInt(key string, value int) query {
+	if value != 0 {
+		url.Values(q).Set(key, strconv.Itoa(value))
+	}
+
+	return q
+}
+
+func (q query) setBool(key string, value *bool) query {
+	if value != nil {
+		url.Values(q).Set(key, strconv.FormatBool(*value))
+	}
+
+	return q
+}
+
+func (q query) setTime(key string, value time.Time) query {
+	if !value.IsZero() {
+		url.Values(q).Set(key, value.UTC().Format(time.RFC3339))
+	}
+
+	return q
+}
+
+// encode returns the escaped query string, sorted by key, with its leading
+// "?", or "" if the query has no params.
+func (q query) encode() string {
+	if len(q) == 0 {
+		return ""
+	}
+
+	return "?" + url.Values(q).Encode()
+}
+
 func zonesEP(c *Client) string {
-	return concatStrs("", c.Host, "/zones")
+	return endpoint(c, nil, "zones")
 }
 
 func pingEP(c *Client) string {
-	return concatStrs("", c.Host, "/ping")
+	return endpoint(c, nil, "ping")
 }
 
 func healthEP(c *Client) string {
-	return concatStrs("", c.Host, "/health")
+	return endpoint(c, nil, "health")
 }
 
 func colorEP(c *Client) string {
-	return concatStrs("", c.Host, "/color")
+	return endpoint(c, nil, "color")
 }
 
 func prometheusMetricsEP(c *Client, names []string) string {
-	base := concatStrs("", c.Host, "/metrics/prometheus")
-	query := buildPrometheusQuery(names)
-	return concatStrs("", base, query)
+	return endpoint(c, buildPrometheusQuery(names), "metrics", "prometheus")
 }
 
 func statusEP(c *Client) string {
-	return concatStrs("", c.Host, "/status")
+	return endpoint(c, nil, "status")
 }
 
 func statusUpdateEP(c *Client, processingDisabled bool) string {
-	return concatStrs("", statusEP(c), fmt.Sprintf("?processingDisabled=%t", processingDisabled))
+	return endpoint(c, query{}.setBool("processingDisabled", &processingDisabled), "status")
 }
 
-func zonesListEP(c *Client, f ListFilter) string {
-	query := buildQuery(f, "nameFilter")
-
-	return concatStrs("", zonesEP(c), query)
+func zonesListEP(c *Client, f ZoneListFilter) string {
+	return endpoint(c, buildZonesListQuery(f), "zones")
 }
 
 func zoneEP(c *Client, id string) string {
-	return concatStrs("", zonesEP(c), "/", id)
+	return endpoint(c, nil, "zones", id)
 }
 
 func zoneDetailsEP(c *Client, id string) string {
-	return concatStrs("", zonesEP(c), "/", id, "/details")
+	return endpoint(c, nil, "zones", id, "details")
 }
 
 func zoneBackendIDsEP(c *Client) string {
-	return concatStrs("", zonesEP(c), "/backendids")
+	return endpoint(c, nil, "zones", "backendids")
 }
 
 func zoneNameEP(c *Client, name string) string {
-	return concatStrs("", zonesEP(c), "/name/", name)
+	return endpoint(c, nil, "zones", "name", name)
 }
 
 func zoneChangesEP(c *Client, id string, f ListFilter) string {
-	query := buildQuery(f, "nameFilter")
-
-	return concatStrs("", zoneEP(c, id), "/changes", query)
+	return endpoint(c, buildQuery(f, "nameFilter"), "zones", id, "changes")
 }
 
 func zoneDeletedChangesEP(c *Client, f DeletedZonesFilter) string {
-	query := buildDeletedZonesQuery(f)
-	return concatStrs("", zonesEP(c), "/deleted/changes", query)
+	return endpoint(c, buildDeletedZonesQuery(f), "zones", "deleted", "changes")
 }
 
 func zoneACLRulesEP(c *Client, id string) string {
-	return concatStrs("", zoneEP(c, id), "/acl/rules")
+	return endpoint(c, nil, "zones", id, "acl", "rules")
 }
 
 func zoneSyncEP(c *Client, id string) string {
-	return concatStrs("", zoneEP(c, id), "/sync")
+	return endpoint(c, nil, "zones", id, "sync")
 }
 
 func recordSetsEP(c *Client, zoneID string) string {
-	return concatStrs("", zoneEP(c, zoneID), "/recordsets")
+	return endpoint(c, nil, "zones", zoneID, "recordsets")
 }
 
-func recordSetsListEP(c *Client, zoneID string, f ListFilter) string {
-	query := buildQuery(f, "recordNameFilter")
-
-	return concatStrs("", recordSetsEP(c, zoneID), query)
+func recordSetsListEP(c *Client, zoneID string, f ZoneRecordSetFilter) string {
+	// the zone and global listings share their query parameters
+	return endpoint(c, buildGlobalListQuery(GlobalListFilter(f)), "zones", zoneID, "recordsets")
 }
 
 func recordSetsGlobalListEP(c *Client, f GlobalListFilter) string {
-	query := buildGlobalListQuery(f)
-	base := concatStrs("", c.Host, "/recordsets")
-
-	return concatStrs("", base, query)
+	return endpoint(c, buildGlobalListQuery(f), "recordsets")
 }
 
 func recordSetEP(c *Client, zoneID, recordSetID string) string {
-	return concatStrs("", recordSetsEP(c, zoneID), "/", recordSetID)
+	return endpoint(c, nil, "zones", zoneID, "recordsets", recordSetID)
 }
 
 func recordSetCountEP(c *Client, zoneID string) string {
-	return concatStrs("", zoneEP(c, zoneID), "/recordsetcount")
+	return endpoint(c, nil, "zones", zoneID, "recordsetcount")
 }
 
 func recordSetChangesEP(c *Client, zoneID string, f ListFilterRecordSetChanges) string {
-	query := buildRecordSetChangesQuery(f)
-
-	return concatStrs("", zoneEP(c, zoneID), "/recordsetchanges", query)
+	return endpoint(c, buildRecordSetChangesQuery(f), "zones", zoneID, "recordsetchanges")
 }
 
 func recordSetChangeEP(c *Client, zoneID, recordSetID, changeID string) string {
-	return concatStrs("", recordSetEP(c, zoneID, recordSetID), "/changes/", changeID)
+	return endpoint(c, nil, "zones", zoneID, "recordsets", recordSetID, "changes", changeID)
 }
 
 func recordSetChangeHistoryEP(c *Client, f RecordSetChangeHistoryFilter) string {
-	query := buildRecordSetChangeHistoryQuery(f)
-	return concatStrs("", c.Host, "/recordsetchange/history", query)
+	return endpoint(c, buildRecordSetChangeHistoryQuery(f), "recordsetchange", "history")
 }
 
 func groupsEP(c *Client) string {
-	return concatStrs("", c.Host, "/groups")
+	return endpoint(c, nil, "groups")
 }
 
 func groupsListEP(c *Client, f ListFilter) string {
-	query := buildQuery(f, "groupNameFilter")
-
-	return concatStrs("", groupsEP(c), query)
+	return endpoint(c, buildQuery(f, "groupNameFilter"), "groups")
 }
 
 func groupEP(c *Client, groupID string) string {
-	return concatStrs("", groupsEP(c), "/", groupID)
+	return endpoint(c, nil, "groups", groupID)
 }
 
 func groupAdminsEP(c *Client, groupID string) string {
-	return concatStrs("", groupEP(c, groupID), "/admins")
+	return endpoint(c, nil, "groups", groupID, "admins")
 }
 
 func groupMembersEP(c *Client, groupID string) string {
-	return concatStrs("", groupEP(c, groupID), "/members")
+	return endpoint(c, nil, "groups", groupID, "members")
 }
 
 func groupActivityEP(c *Client, groupID string) string {
-	return concatStrs("", groupEP(c, groupID), "/activity")
+	return endpoint(c, nil, "groups", groupID, "activity")
 }
 
 func groupChangeEP(c *Client, groupChangeID string) string {
-	return concatStrs("", groupsEP(c), "/change/", groupChangeID)
+	return endpoint(c, nil, "groups", "change", groupChangeID)
 }
 
 func groupValidDomainsEP(c *Client) string {
-	return concatStrs("", groupsEP(c), "/valid/domains")
+	return endpoint(c, nil, "groups", "valid", "domains")
 }
 
 func usersEP(c *Client) string {
-	return concatStrs("", c.Host, "/users")
+	return endpoint(c, nil, "users")
 }
 
 func userEP(c *Client, userIdentifier string) string {
-	return concatStrs("", usersEP(c), "/", userIdentifier)
+	return endpoint(c, nil, "users", userIdentifier)
 }
 
 func userLockEP(c *Client, userID string) string {
-	return concatStrs("", userEP(c, userID), "/lock")
+	return endpoint(c, nil, "users", userID, "lock")
 }
 
 func userUnlockEP(c *Client, userID string) string {
-	return concatStrs("", userEP(c, userID), "/unlock")
+	return endpoint(c, nil, "users", userID, "unlock")
 }
 
 func batchRecordChangesEP(c *Client) string {
-	return concatStrs("", zonesEP(c), "/batchrecordchanges")
+	return endpoint(c, nil, "zones", "batchrecordchanges")
+}
+
+func batchRecordChangesCreateEP(c *Client, opts BatchChangeCreateOptions) string {
+	return endpoint(c, query{}.setBool("allowManualReview", opts.AllowManualReview), "zones", "batchrecordchanges")
+}
+
+func batchRecordChangesListEP(c *Client, f BatchRecordChangesFilter) string {
+	return endpoint(c, buildBatchRecordChangesQuery(f), "zones", "batchrecordchanges")
 }
 
 func batchRecordChangeEP(c *Client, changeID string) string {
-	return concatStrs("", batchRecordChangesEP(c), "/", changeID)
+	return endpoint(c, nil, "zones", "batchrecordchanges", changeID)
 }
 
 func batchRecordChangeApproveEP(c *Client, changeID string) string {
-	return concatStrs("", batchRecordChangeEP(c, changeID), "/approve")
+	return endpoint(c, nil, "zones", "batchrecordchanges", changeID, "approve")
 }
 
 func batchRecordChangeRejectEP(c *Client, changeID string) string {
-	return concatStrs("", batchRecordChangeEP(c, changeID), "/reject")
+	return endpoint(c, nil, "zones", "batchrecordchanges", changeID, "reject")
 }
 
 func batchRecordChangeCancelEP(c *Client, changeID string) string {
-	return concatStrs("", batchRecordChangeEP(c, changeID), "/cancel")
+	return endpoint(c, nil, "zones", "batchrecordchanges", changeID, "cancel")
 }
 
 func zoneChangesFailureEP(c *Client, f ListFilter) string {
-	query := buildStartMaxQuery(f)
-	return concatStrs("", c.Host, "/metrics/health/zonechangesfailure", query)
+	return endpoint(c, buildStartMaxQuery(f), "metrics", "health", "zonechangesfailure")
 }
 
 func recordSetChangesFailureEP(c *Client, zoneID string, f ListFilter) string {
-	query := buildStartMaxQuery(f)
-	return concatStrs("", c.Host, "/metrics/health/zones/", zoneID, "/recordsetchangesfailure", query)
+	return endpoint(c, buildStartMaxQuery(f), "metrics", "health", "zones", zoneID, "recordsetchangesfailure")
 }
 
-func buildQuery(f ListFilter, nameFilterName string) string {
-	params := []string{}
-	query := "?"
-
-	if f.NameFilter != "" {
-		params = append(params, fmt.Sprintf("%s=%s", nameFilterName, f.NameFilter))
-	}
-
-	if f.StartFrom != "" {
-		params = append(params, fmt.Sprintf("startFrom=%s", f.StartFrom))
-	}
-
-	if f.MaxItems != 0 {
-		params = append(params, fmt.Sprintf("maxItems=%d", f.MaxItems))
-	}
-
-	if len(params) == 0 {
-		query = ""
-	}
-
-	return query + strings.Join(params, "&")
+// buildQuery returns the query of a ListFilter, whose NameFilter
+// is passed as the nameFilterName param.
+func buildQuery(f ListFilter, nameFilterName string) query {
+	return buildStartMaxQuery(f).set(nameFilterName, f.NameFilter)
 }
 
-func buildStartMaxQuery(f ListFilter) string {
-	params := []string{}
-	query := "?"
-
-	if f.StartFrom != "" {
-		params = append(params, fmt.Sprintf("startFrom=%s", f.StartFrom))
-	}
-
-	if f.MaxItems != 0 {
-		params = append(params, fmt.Sprintf("maxItems=%d", f.MaxItems))
-	}
-
-	if len(params) == 0 {
-		query = ""
-	}
-
-	return query + strings.Join(params, "&")
+func buildStartMaxQuery(f ListFilter) query {
+	return query{}.
+		set("startFrom", f.StartFrom).
+		setInt("maxItems", f.MaxItems)
 }
 
-func buildDeletedZonesQuery(f DeletedZonesFilter) string {
-	params := []string{}
-	query := "?"
-
-	if f.NameFilter != "" {
-		params = append(params, fmt.Sprintf("nameFilter=%s", f.NameFilter))
+func buildZonesListQuery(f ZoneListFilter) query {
+	q := query{}.
+		set("nameFilter", f.NameFilter).
+		set("startFrom", f.StartFrom).
+		setInt("maxItems", f.MaxItems).
+		setBool("ignoreAccess", f.IgnoreAccess).
+		setBool("includeReverse", f.IncludeReverse)
+	if f.SearchByAdminGroup {
+		q.set("searchByAdminGroup", "true")
 	}
 
-	if f.StartFrom != "" {
-		params = append(params, fmt.Sprintf("startFrom=%s", f.StartFrom))
-	}
-
-	if f.MaxItems != 0 {
-		params = append(params, fmt.Sprintf("maxItems=%d", f.MaxItems))
-	}
-
-	if f.IgnoreAccess != nil {
-		params = append(params, fmt.Sprintf("ignoreAccess=%t", *f.IgnoreAccess))
-	}
-
-	if len(params) == 0 {
-		query = ""
-	}
-
-	return query + strings.Join(params, "&")
+	return q
 }
 
-func buildRecordSetChangesQuery(f ListFilterRecordSetChanges) string {
-	params := []string{}
-	query := "?"
-
-	if f.StartFrom != 0 {
-		params = append(params, fmt.Sprintf("startFrom=%d", f.StartFrom))
-	}
-
-	if f.MaxItems != 0 {
-		params = append(params, fmt.Sprintf("maxItems=%d", f.MaxItems))
-	}
-
-	if len(params) == 0 {
-		query = ""
-	}
-
-	return query + strings.Join(params, "&")
+func buildDeletedZonesQuery(f DeletedZonesFilter) query {
+	return query{}.
+		set("nameFilter", f.NameFilter).
+		set("startFrom", f.StartFrom).
+		setInt("maxItems", f.MaxItems).
+		setBool("ignoreAccess", f.IgnoreAccess)
 }
 
-func buildRecordSetChangeHistoryQuery(f RecordSetChangeHistoryFilter) string {
-	params := []string{}
-	query := "?"
-
-	if f.ZoneID != "" {
-		params = append(params, fmt.Sprintf("zoneId=%s", f.ZoneID))
-	}
-	if f.FQDN != "" {
-		params = append(params, fmt.Sprintf("fqdn=%s", f.FQDN))
-	}
-	if f.RecordType != "" {
-		params = append(params, fmt.Sprintf("recordType=%s", f.RecordType))
-	}
-
-	if f.StartFrom != "" {
-		params = append(params, fmt.Sprintf("startFrom=%s", f.StartFrom))
-	}
-
-	if f.MaxItems != 0 {
-		params = append(params, fmt.Sprintf("maxItems=%d", f.MaxItems))
-	}
-
-	if len(params) == 0 {
-		query = ""
-	}
+func buildRecordSetChangesQuery(f ListFilterRecordSetChanges) query {
+	return query{}.
+		setInt("startFrom", f.StartFrom).
+		setInt("maxItems", f.MaxItems)
+}
 
-	return query + strings.Join(params, "&")
+func buildRecordSetChangeHistoryQuery(f RecordSetChangeHistoryFilter) query {
+	return query{}.
+		set("zoneId", f.ZoneID).
+		set("fqdn", f.FQDN).
+		set("recordType", f.RecordType).
+		set("startFrom", f.StartFrom).
+		setInt("maxItems", f.MaxItems)
 }
 
-func buildPrometheusQuery(names []string) string {
-	params := []string{}
-	query := "?"
+func buildBatchRecordChangesQuery(f BatchRecordChangesFilter) query {
+	return query{}.
+		setInt("startFrom", f.StartFrom).
+		setInt("maxItems", f.MaxItems).
+		setBool("ignoreAccess", f.IgnoreAccess).
+		set("approvalStatus", f.ApprovalStatus).
+		set("userName", f.UserName).
+		set("groupName", f.GroupName).
+		setTime("dateTimeRangeStart", f.DateTimeRangeStart).
+		setTime("dateTimeRangeEnd", f.DateTimeRangeEnd)
+}
 
+func buildPrometheusQuery(names []string) query {
+	q := query{}
 	for _, name := range names {
-		if name != "" {
-			params = append(params, fmt.Sprintf("name=%s", name))
-		}
-	}
-
-	if len(params) == 0 {
-		query = ""
+		q.add("name", name)
 	}
 
-	return query + strings.Join(params, "&")
+	return q
 }
 
-func buildGlobalListQuery(f GlobalListFilter) string {
-	params := []string{}
-	query := "?"
-
-	if f.RecordNameFilter != "" {
-		params = append(params, fmt.Sprintf("%s=%s", "recordNameFilter", f.RecordNameFilter))
-	}
-
-	if f.RecordTypeFilter != "" {
-		params = append(params, fmt.Sprintf("%s=%s", "recordTypeFilter", f.RecordTypeFilter))
-	}
-
-	if f.RecordOwnerGroupFilter != "" {
-		params = append(params, fmt.Sprintf("%s=%s", "recordOwnerGroupFilter", f.RecordOwnerGroupFilter))
-	}
-
-	if f.NameSort != "" {
-		params = append(params, fmt.Sprintf("%s=%s", "nameSort", f.NameSort))
-	}
-
-	if f.StartFrom != "" {
-		params = append(params, fmt.Sprintf("startFrom=%s", f.StartFrom))
-	}
-
-	if f.MaxItems != 0 {
-		params = append(params, fmt.Sprintf("maxItems=%d", f.MaxItems))
-	}
-
-	if len(params) == 0 {
-		query = ""
-	}
-
-	return query + strings.Join(params, "&")
+func buildGlobalListQuery(f GlobalListFilter) query {
+	return query{}.
+		set("recordNameFilter", f.RecordNameFilter).
+		set("recordTypeFilter", f.RecordTypeFilter).
+		set("recordOwnerGroupFilter", f.RecordOwnerGroupFilter).
+		set("nameSort", string(f.NameSort)).
+		set("startFrom", f.StartFrom).
+		setInt("maxItems", f.MaxItems)
 }
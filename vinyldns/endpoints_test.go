@@ -16,14 +16,15 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 )
 
 var c = &Client{
-	"accessKey",
-	"secretKey",
-	"http://host.com",
-	&http.Client{},
-	"go-vinyldns testing",
+	AccessKey:  "accessKey",
+	SecretKey:  "secretKey",
+	Host:       "http://host.com",
+	HTTPClient: &http.Client{},
+	UserAgent:  "go-vinyldns testing",
 }
 
 func TestZonesEP(t *testing.T) {
@@ -38,12 +39,29 @@ func TestZonesEP(t *testing.T) {
 }
 
 func TestZonesListEP(t *testing.T) {
-	zones := zonesListEP(c, ListFilter{
+	zones := zonesListEP(c, ZoneListFilter{
 		NameFilter: "foo",
 		MaxItems:   2,
 		StartFrom:  "123",
 	})
-	expected := "http://host.com/zones?nameFilter=foo&startFrom=123&maxItems=2"
+	expected := "http://host.com/zones?maxItems=2&nameFilter=foo&startFrom=123"
+
+	if zones != expected {
+		fmt.Printf("\nExpected: %s", expected)
+		fmt.Printf("\nActual: %s", zones)
+		t.Error("zonesListEP should return the right endpoint")
+	}
+}
+
+func TestZonesListEPWithZoneListFilterParams(t *testing.T) {
+	ignoreAccess, includeReverse := true, false
+	zones := zonesListEP(c, ZoneListFilter{
+		NameFilter:         "ops",
+		SearchByAdminGroup: true,
+		IgnoreAccess:       &ignoreAccess,
+		IncludeReverse:     &includeReverse,
+	})
+	expected := "http://host.com/zones?ignoreAccess=true&includeReverse=false&nameFilter=ops&searchByAdminGroup=true"
 
 	if zones != expected {
 		fmt.Printf("\nExpected: %s", expected)
@@ -53,7 +71,7 @@ func TestZonesListEP(t *testing.T) {
 }
 
 func TestZonesListEPWithoutAllFilterParams(t *testing.T) {
-	zones := zonesListEP(c, ListFilter{
+	zones := zonesListEP(c, ZoneListFilter{
 		NameFilter: "foo",
 	})
 	expected := "http://host.com/zones?nameFilter=foo"
@@ -66,7 +84,7 @@ func TestZonesListEPWithoutAllFilterParams(t *testing.T) {
 }
 
 func TestZonesListEPWithoutAnyFilterParams(t *testing.T) {
-	zones := zonesListEP(c, ListFilter{})
+	zones := zonesListEP(c, ZoneListFilter{})
 	expected := "http://host.com/zones"
 
 	if zones != expected {
@@ -131,7 +149,7 @@ func TestZoneChangesEP(t *testing.T) {
 }
 
 func TestRecordSetsListEP(t *testing.T) {
-	rs := recordSetsListEP(c, "123", ListFilter{})
+	rs := recordSetsListEP(c, "123", ZoneRecordSetFilter{})
 	expected := "http://host.com/zones/123/recordsets"
 
 	if rs != expected {
@@ -140,7 +158,7 @@ func TestRecordSetsListEP(t *testing.T) {
 		t.Error("recordSetsListEP should return the right endpoint")
 	}
 
-	rs = recordSetsListEP(c, "543", ListFilter{
+	rs = recordSetsListEP(c, "543", ZoneRecordSetFilter{
 		StartFrom: "nextplease",
 	})
 	expected = "http://host.com/zones/543/recordsets?startFrom=nextplease"
@@ -151,11 +169,11 @@ func TestRecordSetsListEP(t *testing.T) {
 		t.Error("recordSetsListEP should return the right endpoint")
 	}
 
-	rs = recordSetsListEP(c, "7", ListFilter{
+	rs = recordSetsListEP(c, "7", ZoneRecordSetFilter{
 		StartFrom: "nextplease",
 		MaxItems:  99,
 	})
-	expected = "http://host.com/zones/7/recordsets?startFrom=nextplease&maxItems=99"
+	expected = "http://host.com/zones/7/recordsets?maxItems=99&startFrom=nextplease"
 
 	if rs != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -163,12 +181,25 @@ func TestRecordSetsListEP(t *testing.T) {
 		t.Error("recordSetsListEP should return the right endpoint")
 	}
 
-	rs = recordSetsListEP(c, "7", ListFilter{
-		NameFilter: "foo",
-		StartFrom:  "nextplease",
-		MaxItems:   99,
+	rs = recordSetsListEP(c, "7", ZoneRecordSetFilter{
+		RecordNameFilter: "foo",
+		StartFrom:        "nextplease",
+		MaxItems:         99,
 	})
-	expected = "http://host.com/zones/7/recordsets?recordNameFilter=foo&startFrom=nextplease&maxItems=99"
+	expected = "http://host.com/zones/7/recordsets?maxItems=99&recordNameFilter=foo&startFrom=nextplease"
+
+	if rs != expected {
+		fmt.Printf("Expected: %s", expected)
+		fmt.Printf("Actual: %s", rs)
+		t.Error("recordSetsListEP should return the right endpoint")
+	}
+
+	rs = recordSetsListEP(c, "7", ZoneRecordSetFilter{
+		RecordTypeFilter:       "A,AAAA",
+		RecordOwnerGroupFilter: "group-1",
+		NameSort:               DESC,
+	})
+	expected = "http://host.com/zones/7/recordsets?nameSort=DESC&recordOwnerGroupFilter=group-1&recordTypeFilter=A%2CAAAA"
 
 	if rs != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -203,7 +234,7 @@ func TestRecordSetsGlobalListEP(t *testing.T) {
 		StartFrom: "nextplease",
 		MaxItems:  99,
 	})
-	expected = "http://host.com/recordsets?startFrom=nextplease&maxItems=99"
+	expected = "http://host.com/recordsets?maxItems=99&startFrom=nextplease"
 
 	if rs != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -216,7 +247,7 @@ func TestRecordSetsGlobalListEP(t *testing.T) {
 		StartFrom:        "nextplease",
 		MaxItems:         99,
 	})
-	expected = "http://host.com/recordsets?recordNameFilter=foo&startFrom=nextplease&maxItems=99"
+	expected = "http://host.com/recordsets?maxItems=99&recordNameFilter=foo&startFrom=nextplease"
 
 	if rs != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -252,7 +283,7 @@ func TestRecordSetChangesEPWithQuery(t *testing.T) {
 		MaxItems:  3,
 		StartFrom: 1,
 	})
-	expected := "http://host.com/zones/123/recordsetchanges?startFrom=1&maxItems=3"
+	expected := "http://host.com/zones/123/recordsetchanges?maxItems=3&startFrom=1"
 
 	if rsc != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -300,7 +331,7 @@ func TestGroupsListEP(t *testing.T) {
 		MaxItems:   2,
 		StartFrom:  "123",
 	})
-	expected := "http://host.com/groups?groupNameFilter=foo&startFrom=123&maxItems=2"
+	expected := "http://host.com/groups?groupNameFilter=foo&maxItems=2&startFrom=123"
 
 	if groups != expected {
 		fmt.Printf("\nExpected: %s", expected)
@@ -454,7 +485,7 @@ func TestZoneExtrasEP(t *testing.T) {
 		MaxItems:     5,
 		IgnoreAccess: &ignoreAccess,
 	})
-	expected = "http://host.com/zones/deleted/changes?nameFilter=foo*&startFrom=next&maxItems=5&ignoreAccess=true"
+	expected = "http://host.com/zones/deleted/changes?ignoreAccess=true&maxItems=5&nameFilter=foo%2A&startFrom=next"
 	if deleted != expected {
 		fmt.Printf("Expected: %s", expected)
 		fmt.Printf("Actual: %s", deleted)
@@ -486,7 +517,7 @@ func TestRecordSetExtrasEP(t *testing.T) {
 		StartFrom:  "1",
 		MaxItems:   2,
 	})
-	expected = "http://host.com/recordsetchange/history?zoneId=z1&fqdn=ok.&recordType=A&startFrom=1&maxItems=2"
+	expected = "http://host.com/recordsetchange/history?fqdn=ok.&maxItems=2&recordType=A&startFrom=1&zoneId=z1"
 	if history != expected {
 		fmt.Printf("Expected: %s", expected)
 		fmt.Printf("Actual: %s", history)
@@ -497,7 +528,7 @@ func TestRecordSetExtrasEP(t *testing.T) {
 		StartFrom: "2",
 		MaxItems:  3,
 	})
-	expected = "http://host.com/metrics/health/zones/z2/recordsetchangesfailure?startFrom=2&maxItems=3"
+	expected = "http://host.com/metrics/health/zones/z2/recordsetchangesfailure?maxItems=3&startFrom=2"
 	if failures != expected {
 		fmt.Printf("Expected: %s", expected)
 		fmt.Printf("Actual: %s", failures)
@@ -580,7 +611,7 @@ func TestZoneChangesFailureEP(t *testing.T) {
 		StartFrom: "2",
 		MaxItems:  3,
 	})
-	expected := "http://host.com/metrics/health/zonechangesfailure?startFrom=2&maxItems=3"
+	expected := "http://host.com/metrics/health/zonechangesfailure?maxItems=3&startFrom=2"
 	if failures != expected {
 		fmt.Printf("Expected: %s", expected)
 		fmt.Printf("Actual: %s", failures)
@@ -592,8 +623,8 @@ func TestBuildQuery(t *testing.T) {
 	query := buildQuery(ListFilter{
 		MaxItems:   1,
 		NameFilter: "foo",
-	}, "theNameFilter")
-	expected := "?theNameFilter=foo&maxItems=1"
+	}, "theNameFilter").encode()
+	expected := "?maxItems=1&theNameFilter=foo"
 
 	if query != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -603,7 +634,7 @@ func TestBuildQuery(t *testing.T) {
 }
 
 func TestBuildQueryWithNoQuery(t *testing.T) {
-	query := buildQuery(ListFilter{}, "")
+	query := buildQuery(ListFilter{}, "").encode()
 	expected := ""
 
 	if query != expected {
@@ -617,8 +648,8 @@ func TestBuildStartMaxQuery(t *testing.T) {
 	query := buildStartMaxQuery(ListFilter{
 		StartFrom: "2",
 		MaxItems:  3,
-	})
-	expected := "?startFrom=2&maxItems=3"
+	}).encode()
+	expected := "?maxItems=3&startFrom=2"
 
 	if query != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -634,8 +665,8 @@ func TestBuildDeletedZonesQuery(t *testing.T) {
 		StartFrom:    "next",
 		MaxItems:     5,
 		IgnoreAccess: &ignoreAccess,
-	})
-	expected := "?nameFilter=foo*&startFrom=next&maxItems=5&ignoreAccess=true"
+	}).encode()
+	expected := "?ignoreAccess=true&maxItems=5&nameFilter=foo%2A&startFrom=next"
 
 	if query != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -651,8 +682,8 @@ func TestBuildRecordSetChangeHistoryQuery(t *testing.T) {
 		RecordType: "A",
 		StartFrom:  "1",
 		MaxItems:   2,
-	})
-	expected := "?zoneId=zone-1&fqdn=ok.&recordType=A&startFrom=1&maxItems=2"
+	}).encode()
+	expected := "?fqdn=ok.&maxItems=2&recordType=A&startFrom=1&zoneId=zone-1"
 
 	if query != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -666,8 +697,8 @@ func TestBuildRecordSetChangeHistoryQuerySkipsEmptyRequiredFields(t *testing.T)
 		ZoneID:    "zone-1",
 		MaxItems:  2,
 		StartFrom: "1",
-	})
-	expected := "?zoneId=zone-1&startFrom=1&maxItems=2"
+	}).encode()
+	expected := "?maxItems=2&startFrom=1&zoneId=zone-1"
 
 	if query != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -677,7 +708,7 @@ func TestBuildRecordSetChangeHistoryQuerySkipsEmptyRequiredFields(t *testing.T)
 }
 
 func TestBuildPrometheusQuery(t *testing.T) {
-	query := buildPrometheusQuery([]string{"one", "two"})
+	query := buildPrometheusQuery([]string{"one", "two"}).encode()
 	expected := "?name=one&name=two"
 
 	if query != expected {
@@ -691,8 +722,8 @@ func TestBuildGlobalListQuery(t *testing.T) {
 	query := buildGlobalListQuery(GlobalListFilter{
 		MaxItems:         1,
 		RecordNameFilter: "foo",
-	})
-	expected := "?recordNameFilter=foo&maxItems=1"
+	}).encode()
+	expected := "?maxItems=1&recordNameFilter=foo"
 
 	if query != expected {
 		fmt.Printf("Expected: %s", expected)
@@ -702,7 +733,7 @@ func TestBuildGlobalListQuery(t *testing.T) {
 }
 
 func TestBuildGlobalListQueryWithNoQuery(t *testing.T) {
-	query := buildGlobalListQuery(GlobalListFilter{})
+	query := buildGlobalListQuery(GlobalListFilter{}).encode()
 	expected := ""
 
 	if query != expected {
@@ -711,3 +742,60 @@ func TestBuildGlobalListQueryWithNoQuery(t *testing.T) {
 		t.Error("buildGlobalListQuery should return the right string")
 	}
 }
+
+func TestEndpointsEscape(t *testing.T) {
+	for _, tc := range []struct {
+		name, actual, expected string
+	}{
+		{
+			name:     "zonesListEP",
+			actual:   zonesListEP(c, ZoneListFilter{NameFilter: "*foo bar+ü", StartFrom: "a&b=c"}),
+			expected: "http://host.com/zones?nameFilter=%2Afoo+bar%2B%C3%BC&startFrom=a%26b%3Dc",
+		},
+		{
+			name:     "recordSetsGlobalListEP",
+			actual:   recordSetsGlobalListEP(c, GlobalListFilter{RecordNameFilter: "www*", NameSort: DESC}),
+			expected: "http://host.com/recordsets?nameSort=DESC&recordNameFilter=www%2A",
+		},
+		{
+			name:     "zoneNameEP",
+			actual:   zoneNameEP(c, "foo bar/baz.?"),
+			expected: "http://host.com/zones/name/foo%20bar%2Fbaz.%3F",
+		},
+		{
+			name:     "userEP",
+			actual:   userEP(c, "first last#1"),
+			expected: "http://host.com/users/first%20last%231",
+		},
+		{
+			name:     "statusUpdateEP",
+			actual:   statusUpdateEP(c, false),
+			expected: "http://host.com/status?processingDisabled=false",
+		},
+	} {
+		if tc.actual != tc.expected {
+			t.Errorf("%s: expected %s; got %s", tc.name, tc.expected, tc.actual)
+		}
+	}
+}
+
+func TestBatchRecordChangesListEP(t *testing.T) {
+	ignoreAccess := true
+	ep := batchRecordChangesListEP(c, BatchRecordChangesFilter{
+		StartFrom:          10,
+		MaxItems:           5,
+		IgnoreAccess:       &ignoreAccess,
+		ApprovalStatus:     ApprovalStatusPendingReview,
+		UserName:           "some user",
+		GroupName:          "ops",
+		DateTimeRangeStart: time.Date(2026, 1, 2, 3, 4, 5, 0, time.FixedZone("EST", -5*60*60)),
+	})
+	expected := "http://host.com/zones/batchrecordchanges?approvalStatus=PendingReview&dateTimeRangeStart=2026-01-02T08%3A04%3A05Z&groupName=ops&ignoreAccess=true&maxItems=5&startFrom=10&userName=some+user"
+
+	if ep != expected {
+		t.Errorf("Expected %s; got %s", expected, ep)
+	}
+	if ep := batchRecordChangesListEP(c, BatchRecordChangesFilter{}); ep != "http://host.com/zones/batchrecordchanges" {
+		t.Errorf("Expected no query; got %s", ep)
+	}
+}
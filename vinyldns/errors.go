@@ -0,0 +1,125 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Sentinel errors matched by an *Error via errors.Is, according to
+// the response code and message returned by the vinyldns API.
+var (
+	// ErrNotFound matches 404 Not Found responses.
+	ErrNotFound = errors.New("vinyldns: not found")
+
+	// ErrConflict matches 409 Conflict responses.
+	ErrConflict = errors.New("vinyldns: conflict")
+
+	// ErrUnauthorized matches 401 Unauthorized responses.
+	ErrUnauthorized = errors.New("vinyldns: unauthorized")
+
+	// ErrForbidden matches 403 Forbidden responses.
+	ErrForbidden = errors.New("vinyldns: forbidden")
+
+	// ErrPendingChange matches 409 Conflict responses caused by
+	// the zone or record set already having a change in progress.
+	ErrPendingChange = errors.New("vinyldns: pending change")
+
+	// ErrRateLimited matches 429 Too Many Requests responses.
+	ErrRateLimited = errors.New("vinyldns: rate limited")
+
+	// ErrScheduledChangesDisabled matches 400 Bad Request responses to
+	// scheduled batch changes made to a server that doesn't allow them.
+	ErrScheduledChangesDisabled = errors.New("vinyldns: scheduled changes disabled")
+
+	// ErrScheduledTimeInPast matches 400 Bad Request responses to
+	// batch changes scheduled for a time that isn't in the future.
+	ErrScheduledTimeInPast = errors.New("vinyldns: scheduled time in the past")
+
+	// ErrScheduledChangeNotDue matches 403 Forbidden responses to
+	// approving a scheduled batch change before its scheduled time.
+	ErrScheduledChangeNotDue = errors.New("vinyldns: scheduled change not due")
+)
+
+// Is reports whether the Error matches the sentinel error target,
+// allowing callers to use errors.Is(err, vinyldns.ErrNotFound).
+func (d Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return d.ResponseCode == http.StatusNotFound
+	case ErrConflict:
+		return d.ResponseCode == http.StatusConflict
+	case ErrUnauthorized:
+		return d.ResponseCode == http.StatusUnauthorized
+	case ErrForbidden:
+		return d.ResponseCode == http.StatusForbidden
+	case ErrPendingChange:
+		return d.ResponseCode == http.StatusConflict &&
+			strings.Contains(strings.ToLower(d.message()), "pending")
+	case ErrRateLimited:
+		return d.ResponseCode == http.StatusTooManyRequests
+	case ErrScheduledChangesDisabled:
+		return d.ResponseCode == http.StatusBadRequest &&
+			strings.Contains(strings.ToLower(d.message()), "scheduled change, as it is currently disabled")
+	case ErrScheduledTimeInPast:
+		return d.ResponseCode == http.StatusBadRequest &&
+			strings.Contains(strings.ToLower(d.message()), "scheduled time must be in the future")
+	case ErrScheduledChangeNotDue:
+		return d.ResponseCode == http.StatusForbidden &&
+			strings.Contains(strings.ToLower(d.message()), "not past the scheduled date")
+	}
+
+	return false
+}
+
+func (d Error) message() string {
+	if d.Message != "" {
+		return d.Message
+	}
+
+	return d.ResponseBody
+}
+
+// errorResponse represents the JSON error payloads
+// returned by the vinyldns API.
+type errorResponse struct {
+	Errors  []string `json:"errors"`
+	Message string   `json:"message"`
+}
+
+// parseErrorMessage extracts the error message from a vinyldns API response body,
+// which is either plain text, a JSON string, or a JSON object holding
+// an "errors" list or a "message".
+func parseErrorMessage(body []byte) string {
+	trimmed := strings.TrimSpace(string(body))
+
+	var str string
+	if err := json.Unmarshal([]byte(trimmed), &str); err == nil {
+		return str
+	}
+
+	resp := errorResponse{}
+	if err := json.Unmarshal([]byte(trimmed), &resp); err == nil {
+		if len(resp.Errors) > 0 {
+			return strings.Join(resp.Errors, "; ")
+		}
+		if resp.Message != "" {
+			return resp.Message
+		}
+	}
+
+	return trimmed
+}
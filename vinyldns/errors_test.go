@@ -0,0 +1,94 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrorIs(t *testing.T) {
+	cases := []struct {
+		code    int
+		body    string
+		matches []error
+		misses  []error
+	}{
+		{404, "Zone with id 123 not found", []error{ErrNotFound}, []error{ErrConflict, ErrForbidden}},
+		{401, "Authentication Failed", []error{ErrUnauthorized}, []error{ErrForbidden}},
+		{403, "User is not authorized", []error{ErrForbidden}, []error{ErrUnauthorized}},
+		{409, "Zone with name ok. already exists", []error{ErrConflict}, []error{ErrPendingChange}},
+		{409, "RecordSet with id 123 is currently pending", []error{ErrConflict, ErrPendingChange}, nil},
+		{429, "", []error{ErrRateLimited}, []error{ErrNotFound}},
+		{400, "Cannot create a scheduled change, as it is currently disabled on this VinylDNS instance.", []error{ErrScheduledChangesDisabled}, []error{ErrScheduledTimeInPast}},
+		{400, "Scheduled time must be in the future.", []error{ErrScheduledTimeInPast}, []error{ErrScheduledChangesDisabled}},
+		{403, "Cannot process scheduled change as it is not past the scheduled date of 2026-10-18T12:00:00Z", []error{ErrForbidden, ErrScheduledChangeNotDue}, nil},
+		{403, "User is not authorized", []error{ErrForbidden}, []error{ErrScheduledChangeNotDue}},
+	}
+
+	for _, tc := range cases {
+		err := fmt.Errorf("wrapped: %w", &Error{ResponseCode: tc.code, Message: parseErrorMessage([]byte(tc.body))})
+
+		for _, target := range tc.matches {
+			if !errors.Is(err, target) {
+				t.Errorf("Expected %d %q to match %v", tc.code, tc.body, target)
+			}
+		}
+		for _, target := range tc.misses {
+			if errors.Is(err, target) {
+				t.Errorf("Expected %d %q not to match %v", tc.code, tc.body, target)
+			}
+		}
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	cases := map[string]string{
+		"Zone with id 123 not found\n":                 "Zone with id 123 not found",
+		`"quoted message"`:                             "quoted message",
+		`{"errors":["Missing Zone.name","Bad email"]}`: "Missing Zone.name; Bad email",
+		`{"message":"something broke"}`:                "something broke",
+		"":                                             "",
+	}
+
+	for body, expected := range cases {
+		if got := parseErrorMessage([]byte(body)); got != expected {
+			t.Errorf("Expected message %q for body %q; got %q", expected, body, got)
+		}
+	}
+}
+
+func TestErrorMessageFromResponse(t *testing.T) {
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/123",
+			code:     404,
+			body:     `Zone with id 123 not found`,
+		},
+	})
+	defer server.Close()
+
+	_, err := client.Zone("123")
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound; got %v", err)
+	}
+
+	var vErr *Error
+	if !errors.As(err, &vErr) {
+		t.Fatal("Expected a *vinyldns.Error")
+	}
+	if vErr.Message != "Zone with id 123 not found" {
+		t.Errorf("Expected parsed Message; got %q", vErr.Message)
+	}
+}
@@ -13,14 +13,22 @@ limitations under the License.
 package vinyldns
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 )
 
 // Groups retrieves a list of Groups that the requester is a part of.
 func (c *Client) Groups() ([]Group, error) {
+	return c.GroupsWithContext(context.Background())
+}
+
+// GroupsWithContext retrieves a list of Groups that the requester is a part of,
+// using ctx for the lifetime of the request.
+func (c *Client) GroupsWithContext(ctx context.Context) ([]Group, error) {
 	groups := &Groups{}
-	err := resourceRequest(c, groupsEP(c), "GET", nil, groups)
+	err := resourceRequest(ctx, c, groupsEP(c), "GET", nil, groups)
 	if err != nil {
 		return []Group{}, err
 	}
@@ -31,6 +39,12 @@ func (c *Client) Groups() ([]Group, error) {
 // GroupsListAll retrieves the complete list of groups with the ListFilter criteria passed.
 // Handles paging through results on the user's behalf.
 func (c *Client) GroupsListAll(filter ListFilter) ([]Group, error) {
+	return c.GroupsListAllWithContext(context.Background(), filter)
+}
+
+// GroupsListAllWithContext is GroupsListAll with a context.Context
+// that is applied to each page request.
+func (c *Client) GroupsListAllWithContext(ctx context.Context, filter ListFilter) ([]Group, error) {
 	if filter.MaxItems > 100 {
 		return nil, fmt.Errorf("MaxItems must be between 1 and 100")
 	}
@@ -38,7 +52,7 @@ func (c *Client) GroupsListAll(filter ListFilter) ([]Group, error) {
 	groups := []Group{}
 
 	for {
-		resp, err := c.groupsList(filter)
+		resp, err := c.groupsList(ctx, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -52,14 +66,41 @@ func (c *Client) GroupsListAll(filter ListFilter) ([]Group, error) {
 	}
 }
 
+// GroupsPager returns a Pager over the groups matching the ListFilter criteria
+// passed, fetching pages lazily starting at filter.StartFrom.
+func (c *Client) GroupsPager(ctx context.Context, filter ListFilter) *Pager[Group, string] {
+	return NewPager(ctx, filter.StartFrom, func(ctx context.Context, startFrom string) ([]Group, string, error) {
+		if filter.MaxItems > 100 {
+			return nil, "", fmt.Errorf("MaxItems must be between 1 and 100")
+		}
+		filter.StartFrom = startFrom
+		resp, err := c.groupsList(ctx, filter)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Groups, resp.NextID, nil
+	})
+}
+
+// GroupsIter returns an iterator over the groups matching the ListFilter criteria passed.
+func (c *Client) GroupsIter(ctx context.Context, filter ListFilter) iter.Seq2[Group, error] {
+	return c.GroupsPager(ctx, filter).All()
+}
+
 // GroupCreate creates the Group it's passed.
 func (c *Client) GroupCreate(g *Group) (*Group, error) {
+	return c.GroupCreateWithContext(context.Background(), g)
+}
+
+// GroupCreateWithContext creates the Group it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) GroupCreateWithContext(ctx context.Context, g *Group) (*Group, error) {
 	gJSON, err := json.Marshal(g)
 	if err != nil {
 		return nil, err
 	}
 	var resource = &Group{}
-	err = resourceRequest(c, groupsEP(c), "POST", gJSON, resource)
+	err = resourceRequest(ctx, c, groupsEP(c), "POST", gJSON, resource)
 	if err != nil {
 		return &Group{}, err
 	}
@@ -69,8 +110,14 @@ func (c *Client) GroupCreate(g *Group) (*Group, error) {
 
 // Group gets the Group whose ID it's passed.
 func (c *Client) Group(groupID string) (*Group, error) {
+	return c.GroupWithContext(context.Background(), groupID)
+}
+
+// GroupWithContext gets the Group whose ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) GroupWithContext(ctx context.Context, groupID string) (*Group, error) {
 	group := &Group{}
-	err := resourceRequest(c, groupEP(c, groupID), "GET", nil, group)
+	err := resourceRequest(ctx, c, groupEP(c, groupID), "GET", nil, group)
 	if err != nil {
 		return nil, err
 	}
@@ -80,8 +127,14 @@ func (c *Client) Group(groupID string) (*Group, error) {
 
 // GroupDelete deletes the Group whose ID it's passed.
 func (c *Client) GroupDelete(groupID string) (*Group, error) {
+	return c.GroupDeleteWithContext(context.Background(), groupID)
+}
+
+// GroupDeleteWithContext deletes the Group whose ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) GroupDeleteWithContext(ctx context.Context, groupID string) (*Group, error) {
 	group := &Group{}
-	err := resourceRequest(c, groupEP(c, groupID), "DELETE", nil, group)
+	err := resourceRequest(ctx, c, groupEP(c, groupID), "DELETE", nil, group)
 	if err != nil {
 		return nil, err
 	}
@@ -91,12 +144,18 @@ func (c *Client) GroupDelete(groupID string) (*Group, error) {
 
 // GroupUpdate updates the Group whose ID it's passed.
 func (c *Client) GroupUpdate(groupID string, g *Group) (*Group, error) {
+	return c.GroupUpdateWithContext(context.Background(), groupID, g)
+}
+
+// GroupUpdateWithContext updates the Group whose ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) GroupUpdateWithContext(ctx context.Context, groupID string, g *Group) (*Group, error) {
 	gJSON, err := json.Marshal(g)
 	if err != nil {
 		return nil, err
 	}
 	var resource = &Group{}
-	err = resourceRequest(c, groupEP(c, groupID), "PUT", gJSON, resource)
+	err = resourceRequest(ctx, c, groupEP(c, groupID), "PUT", gJSON, resource)
 	if err != nil {
 		return &Group{}, err
 	}
@@ -107,8 +166,15 @@ func (c *Client) GroupUpdate(groupID string, g *Group) (*Group, error) {
 // GroupAdmins returns an array of Users that are admins
 // associated with the Group whose GroupID it's passed.
 func (c *Client) GroupAdmins(groupID string) ([]User, error) {
+	return c.GroupAdminsWithContext(context.Background(), groupID)
+}
+
+// GroupAdminsWithContext returns an array of Users that are admins
+// associated with the Group whose GroupID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) GroupAdminsWithContext(ctx context.Context, groupID string) ([]User, error) {
 	admins := &GroupAdmins{}
-	err := resourceRequest(c, groupAdminsEP(c, groupID), "GET", nil, admins)
+	err := resourceRequest(ctx, c, groupAdminsEP(c, groupID), "GET", nil, admins)
 	if err != nil {
 		return nil, err
 	}
@@ -119,8 +185,15 @@ func (c *Client) GroupAdmins(groupID string) ([]User, error) {
 // GroupMembers returns an array of Users that are members
 // associated with the Group whose GroupID it's passed.
 func (c *Client) GroupMembers(groupID string) ([]User, error) {
+	return c.GroupMembersWithContext(context.Background(), groupID)
+}
+
+// GroupMembersWithContext returns an array of Users that are members
+// associated with the Group whose GroupID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) GroupMembersWithContext(ctx context.Context, groupID string) ([]User, error) {
 	members := &GroupMembers{}
-	err := resourceRequest(c, groupMembersEP(c, groupID), "GET", nil, members)
+	err := resourceRequest(ctx, c, groupMembersEP(c, groupID), "GET", nil, members)
 	if err != nil {
 		return nil, err
 	}
@@ -131,8 +204,15 @@ func (c *Client) GroupMembers(groupID string) ([]User, error) {
 // GroupActivity returns group change activity
 // associated with the Group whose GroupID it's passed.
 func (c *Client) GroupActivity(groupID string) (*GroupChanges, error) {
+	return c.GroupActivityWithContext(context.Background(), groupID)
+}
+
+// GroupActivityWithContext returns group change activity
+// associated with the Group whose GroupID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) GroupActivityWithContext(ctx context.Context, groupID string) (*GroupChanges, error) {
 	activity := &GroupChanges{}
-	err := resourceRequest(c, groupActivityEP(c, groupID), "GET", nil, activity)
+	err := resourceRequest(ctx, c, groupActivityEP(c, groupID), "GET", nil, activity)
 	if err != nil {
 		return nil, err
 	}
@@ -142,8 +222,14 @@ func (c *Client) GroupActivity(groupID string) (*GroupChanges, error) {
 
 // GroupChange retrieves a group change by ID.
 func (c *Client) GroupChange(groupChangeID string) (*GroupChange, error) {
+	return c.GroupChangeWithContext(context.Background(), groupChangeID)
+}
+
+// GroupChangeWithContext retrieves a group change by ID,
+// using ctx for the lifetime of the request.
+func (c *Client) GroupChangeWithContext(ctx context.Context, groupChangeID string) (*GroupChange, error) {
 	change := &GroupChange{}
-	err := resourceRequest(c, groupChangeEP(c, groupChangeID), "GET", nil, change)
+	err := resourceRequest(ctx, c, groupChangeEP(c, groupChangeID), "GET", nil, change)
 	if err != nil {
 		return nil, err
 	}
@@ -153,8 +239,14 @@ func (c *Client) GroupChange(groupChangeID string) (*GroupChange, error) {
 
 // GroupValidDomains retrieves valid email domains for groups.
 func (c *Client) GroupValidDomains() ([]string, error) {
+	return c.GroupValidDomainsWithContext(context.Background())
+}
+
+// GroupValidDomainsWithContext retrieves valid email domains for groups,
+// using ctx for the lifetime of the request.
+func (c *Client) GroupValidDomainsWithContext(ctx context.Context) ([]string, error) {
 	var domains []string
-	err := resourceRequest(c, groupValidDomainsEP(c), "GET", nil, &domains)
+	err := resourceRequest(ctx, c, groupValidDomainsEP(c), "GET", nil, &domains)
 	if err != nil {
 		return nil, err
 	}
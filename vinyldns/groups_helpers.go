@@ -12,10 +12,12 @@ limitations under the License.
 
 package vinyldns
 
+import "context"
+
 // groupsList retrieves the list of zones with the List criteria passed.
-func (c *Client) groupsList(filter ListFilter) (*Groups, error) {
+func (c *Client) groupsList(ctx context.Context, filter ListFilter) (*Groups, error) {
 	groups := &Groups{}
-	err := resourceRequest(c, groupsListEP(c, filter), "GET", nil, groups)
+	err := resourceRequest(ctx, c, groupsListEP(c, filter), "GET", nil, groups)
 	if err != nil {
 		return groups, err
 	}
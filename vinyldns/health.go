@@ -12,9 +12,17 @@ limitations under the License.
 
 package vinyldns
 
+import "context"
+
 // Ping performs a health check that returns "PONG".
 func (c *Client) Ping() (string, error) {
-	_, body, err := resourceRequestRaw(c, pingEP(c), "GET", nil)
+	return c.PingWithContext(context.Background())
+}
+
+// PingWithContext performs a health check that returns "PONG",
+// using ctx for the lifetime of the request.
+func (c *Client) PingWithContext(ctx context.Context) (string, error) {
+	_, body, err := resourceRequestRaw(ctx, c, pingEP(c), "GET", nil)
 	if err != nil {
 		return "", err
 	}
@@ -24,13 +32,25 @@ func (c *Client) Ping() (string, error) {
 
 // Health performs a comprehensive health check.
 func (c *Client) Health() error {
-	_, _, err := resourceRequestRaw(c, healthEP(c), "GET", nil)
+	return c.HealthWithContext(context.Background())
+}
+
+// HealthWithContext performs a comprehensive health check,
+// using ctx for the lifetime of the request.
+func (c *Client) HealthWithContext(ctx context.Context) error {
+	_, _, err := resourceRequestRaw(ctx, c, healthEP(c), "GET", nil)
 	return err
 }
 
 // Color returns the current blue/green deployment color.
 func (c *Client) Color() (string, error) {
-	_, body, err := resourceRequestRaw(c, colorEP(c), "GET", nil)
+	return c.ColorWithContext(context.Background())
+}
+
+// ColorWithContext returns the current blue/green deployment color,
+// using ctx for the lifetime of the request.
+func (c *Client) ColorWithContext(ctx context.Context) (string, error) {
+	_, body, err := resourceRequestRaw(ctx, c, colorEP(c), "GET", nil)
 	if err != nil {
 		return "", err
 	}
@@ -40,7 +60,13 @@ func (c *Client) Color() (string, error) {
 
 // MetricsPrometheus returns metrics in Prometheus text format.
 func (c *Client) MetricsPrometheus(names []string) (string, error) {
-	_, body, err := resourceRequestRaw(c, prometheusMetricsEP(c, names), "GET", nil)
+	return c.MetricsPrometheusWithContext(context.Background(), names)
+}
+
+// MetricsPrometheusWithContext returns metrics in Prometheus text format,
+// using ctx for the lifetime of the request.
+func (c *Client) MetricsPrometheusWithContext(ctx context.Context, names []string) (string, error) {
+	_, body, err := resourceRequestRaw(ctx, c, prometheusMetricsEP(c, names), "GET", nil)
 	if err != nil {
 		return "", err
 	}
@@ -26,19 +26,19 @@ import (
 // see `make start-api` for a Make task in starting VinylDNS
 func client() *Client {
 	return NewClient(ClientConfiguration{
-		"okAccessKey",
-		"okSecretKey",
-		"http://localhost:9000",
-		"go-vinyldns integration testing",
+		AccessKey: "okAccessKey",
+		SecretKey: "okSecretKey",
+		Host:      "http://localhost:9000",
+		UserAgent: "go-vinyldns integration testing",
 	})
 }
 
 func superUser() *Client {
 	return NewClient(ClientConfiguration{
-		"superUserAccessKey",
-		"superUserSecretKey",
-		"http://localhost:9000",
-		"go-vinyldns integration testing (super user)",
+		AccessKey: "superUserAccessKey",
+		SecretKey: "superUserSecretKey",
+		Host:      "http://localhost:9000",
+		UserAgent: "go-vinyldns integration testing (super user)",
 	})
 }
 
@@ -62,11 +62,11 @@ func testTools(configs []testToolsConfig) (*httptest.Server, *Client) {
 	}
 
 	client := &Client{
-		"accessToken",
-		"secretToken",
-		host,
-		&http.Client{Transport: tr},
-		"go-vinyldns testing",
+		AccessKey:  "accessToken",
+		SecretKey:  "secretToken",
+		Host:       host,
+		HTTPClient: &http.Client{Transport: tr},
+		UserAgent:  "go-vinyldns testing",
 	}
 
 	return server, client
@@ -0,0 +1,101 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"context"
+	"iter"
+)
+
+// Pager lazily pages through a vinyldns listing, fetching each page
+// only when the previous one has been consumed.
+//
+// T is the listed resource and C is the type of the listing's
+// startFrom/nextId cursor. The zero value of C marks the end of the listing.
+type Pager[T any, C comparable] struct {
+	ctx   context.Context
+	fetch func(ctx context.Context, startFrom C) ([]T, C, error)
+
+	start   C
+	next    C
+	page    []T
+	pos     int
+	fetched bool
+	done    bool
+}
+
+// NewPager returns a Pager starting at startFrom that fetches each page
+// with fetch, which returns the page's items and the cursor of the next page.
+// It is exported chiefly so that mocks of the API interfaces can return Pagers.
+func NewPager[T any, C comparable](ctx context.Context, startFrom C, fetch func(context.Context, C) ([]T, C, error)) *Pager[T, C] {
+	return &Pager[T, C]{
+		ctx:   ctx,
+		fetch: fetch,
+		start: startFrom,
+	}
+}
+
+// All returns an iterator over the remaining items in the listing.
+// Iteration stops after yielding the first error encountered.
+//
+// Breaking out of the loop leaves the Pager positioned at the next
+// unyielded item, so a later call to All resumes where the previous one
+// stopped; this includes retrying a page whose fetch failed.
+func (p *Pager[T, C]) All() iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for !p.done {
+			if !p.fetched {
+				items, next, err := p.fetch(p.ctx, p.start)
+				if err != nil {
+					var zero T
+					yield(zero, err)
+					return
+				}
+				p.page, p.next, p.pos, p.fetched = items, next, 0, true
+			}
+
+			for p.pos < len(p.page) {
+				item := p.page[p.pos]
+				p.pos++
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			var zero C
+			if p.next == zero {
+				p.done = true
+			}
+			p.start, p.page, p.fetched = p.next, nil, false
+		}
+	}
+}
+
+// Cursor returns the startFrom value from which the listing can be resumed,
+// for instance by a later process after a restart.
+//
+// Pages cannot be partially skipped, so when iteration stopped part-way
+// through a page, the cursor points at the start of that page and resuming
+// from it yields that page's already-consumed items again.
+func (p *Pager[T, C]) Cursor() C {
+	if p.fetched && p.pos >= len(p.page) {
+		return p.next
+	}
+
+	return p.start
+}
+
+// Done reports whether the listing has been exhausted.
+func (p *Pager[T, C]) Done() bool {
+	return p.done
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestZonesIter(t *testing.T) {
+	zonesListJSON1, err := readFile("test-fixtures/zones/zones-list-1.json")
+	if err != nil {
+		t.Error(err)
+	}
+	zonesListJSON2, err := readFile("test-fixtures/zones/zones-list-2.json")
+	if err != nil {
+		t.Error(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones?maxItems=1",
+			code:     200,
+			body:     zonesListJSON1,
+		},
+		{
+			endpoint: "http://host.com/zones?maxItems=1&startFrom=2",
+			code:     200,
+			body:     zonesListJSON2,
+		},
+	})
+	defer server.Close()
+
+	ids := []string{}
+	for z, err := range client.ZonesIter(context.Background(), ListFilter{MaxItems: 1}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, z.ID)
+	}
+
+	if len(ids) != 2 || ids[0] != "1" || ids[1] != "2" {
+		t.Errorf("Expected zones 1 and 2; got %v", ids)
+	}
+}
+
+func TestZonesPagerResumesAfterBreak(t *testing.T) {
+	zonesListJSON1, err := readFile("test-fixtures/zones/zones-list-1.json")
+	if err != nil {
+		t.Error(err)
+	}
+	zonesListJSON2, err := readFile("test-fixtures/zones/zones-list-2.json")
+	if err != nil {
+		t.Error(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones?maxItems=1",
+			code:     200,
+			body:     zonesListJSON1,
+		},
+		{
+			endpoint: "http://host.com/zones?maxItems=1&startFrom=2",
+			code:     200,
+			body:     zonesListJSON2,
+		},
+	})
+	defer server.Close()
+
+	pager := client.ZonesPager(context.Background(), ListFilter{MaxItems: 1})
+	for z, err := range pager.All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if z.ID != "1" {
+			t.Errorf("Expected first zone to be 1; got %s", z.ID)
+		}
+		break
+	}
+
+	if pager.Cursor() != "2" {
+		t.Errorf("Expected cursor to be 2 after consuming the first page; got %q", pager.Cursor())
+	}
+	if pager.Done() {
+		t.Error("Expected pager not to be done")
+	}
+
+	resumed := client.ZonesPager(context.Background(), ListFilter{MaxItems: 1, StartFrom: pager.Cursor()})
+	ids := []string{}
+	for z, err := range resumed.All() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, z.ID)
+	}
+	if len(ids) != 1 || ids[0] != "2" {
+		t.Errorf("Expected resumed pager to yield zone 2; got %v", ids)
+	}
+	if !resumed.Done() {
+		t.Error("Expected resumed pager to be done")
+	}
+}
+
+func TestPagerCursorWithinPage(t *testing.T) {
+	pages := map[string][]int{"": {1, 2, 3}, "next": {4}}
+	nexts := map[string]string{"": "next"}
+	pager := NewPager(context.Background(), "", func(_ context.Context, startFrom string) ([]int, string, error) {
+		return pages[startFrom], nexts[startFrom], nil
+	})
+
+	for i := range pager.All() {
+		if i == 2 {
+			break
+		}
+	}
+	if pager.Cursor() != "" {
+		t.Errorf("Expected cursor to point at the partially consumed page; got %q", pager.Cursor())
+	}
+
+	rest := []int{}
+	for i := range pager.All() {
+		rest = append(rest, i)
+	}
+	if len(rest) != 2 || rest[0] != 3 || rest[1] != 4 {
+		t.Errorf("Expected All to continue with 3 and 4; got %v", rest)
+	}
+}
+
+func TestPagerStopsOnError(t *testing.T) {
+	fail := errors.New("boom")
+	pager := NewPager(context.Background(), 0, func(_ context.Context, startFrom int) ([]string, int, error) {
+		return nil, 0, fail
+	})
+
+	count := 0
+	for _, err := range pager.All() {
+		count++
+		if !errors.Is(err, fail) {
+			t.Errorf("Expected error to be yielded; got %v", err)
+		}
+	}
+	if count != 1 {
+		t.Errorf("Expected a single yield; got %d", count)
+	}
+}
+
+func TestRecordSetChangesIterMaxItems(t *testing.T) {
+	for _, err := range c.RecordSetChangesIter(context.Background(), "123", ListFilterRecordSetChanges{MaxItems: 200}) {
+		if err == nil {
+			t.Error("Expected error -- MaxItems must be between 1 and 100")
+		}
+	}
+}
+
+func TestZonesIterFiltered(t *testing.T) {
+	zonesListJSON1, err := readFile("test-fixtures/zones/zones-list-1.json")
+	if err != nil {
+		t.Error(err)
+	}
+	zonesListJSON2, err := readFile("test-fixtures/zones/zones-list-2.json")
+	if err != nil {
+		t.Error(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones?ignoreAccess=true&includeReverse=false&maxItems=1",
+			code:     200,
+			body:     zonesListJSON1,
+		},
+		{
+			endpoint: "http://host.com/zones?ignoreAccess=true&includeReverse=false&maxItems=1&startFrom=2",
+			code:     200,
+			body:     zonesListJSON2,
+		},
+	})
+	defer server.Close()
+
+	ignoreAccess, includeReverse := true, false
+	filter := ZoneListFilter{IgnoreAccess: &ignoreAccess, IncludeReverse: &includeReverse, MaxItems: 1}
+	ids := []string{}
+	for z, err := range client.ZonesIterFiltered(context.Background(), filter) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, z.ID)
+	}
+
+	if len(ids) != 2 || ids[0] != "1" || ids[1] != "2" {
+		t.Errorf("Expected zones 1 and 2; got %v", ids)
+	}
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// PlanAction is the action a Plan takes on a record set.
+type PlanAction string
+
+const (
+	// PlanActionCreate creates a record set that doesn't exist.
+	PlanActionCreate PlanAction = "create"
+	// PlanActionUpdate updates a record set that differs from its desired state.
+	PlanActionUpdate PlanAction = "update"
+	// PlanActionDelete deletes a record set absent from the desired state.
+	PlanActionDelete PlanAction = "delete"
+	// PlanActionNoOp leaves a record set that matches its desired state.
+	PlanActionNoOp PlanAction = "no-op"
+)
+
+// PlanOptions configures RecordSetsPlan and NewPlan.
+type PlanOptions struct {
+	// Prune deletes record sets absent from the desired state. Without it,
+	// such record sets are left alone, unless they conflict with a desired CNAME.
+	// The SOA and apex NS record sets are never pruned.
+	Prune bool
+
+	// MaxDeletes, if positive, is the most deletes a plan may contain.
+	// Planning fails rather than produce a plan with more.
+	MaxDeletes int
+}
+
+// PlanChange is a single record set change in a Plan.
+type PlanChange struct {
+	Action PlanAction
+
+	// Current is the record set as it exists; nil for a create.
+	Current *RecordSet
+
+	// Desired is the record set as it should be; nil for a delete.
+	Desired *RecordSet
+
+	// Reason explains why the change is needed, such as a delete
+	// resolving a CNAME conflict. It may be empty.
+	Reason string
+}
+
+// Plan is the set of changes that reconciles a zone's record sets with their
+// desired state. Its changes are ordered as they're applied: deletes, so that
+// conflicting CNAMEs are removed before their replacements are created, then
+// updates, then creates, then no-ops.
+type Plan struct {
+	Zone    Zone
+	Changes []PlanChange
+}
+
+// RecordSetsPlan returns the Plan that reconciles the record sets of the zone whose ID
+// it's passed with the desired record sets. Desired record set names may be relative
+// to the zone, absolute, or "@" for the apex. See NewPlan.
+func (c *Client) RecordSetsPlan(zoneID string, desired []RecordSet, opts PlanOptions) (*Plan, error) {
+	return c.RecordSetsPlanWithContext(context.Background(), zoneID, desired, opts)
+}
+
+// RecordSetsPlanWithContext returns the Plan that reconciles the record sets of the zone
+// whose ID it's passed with the desired record sets, using ctx for the lifetime of the
+// requests. See NewPlan.
+func (c *Client) RecordSetsPlanWithContext(ctx context.Context, zoneID string, desired []RecordSet, opts PlanOptions) (*Plan, error) {
+	zone, err := c.ZoneWithContext(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.RecordSetsListAllWithContext(ctx, zoneID, ListFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPlan(zone, current, desired, opts)
+}
+
+// NewPlan returns the Plan that reconciles the zone's current record sets with the
+// desired record sets, which are matched by name and type. Desired SOA record sets
+// are ignored, as VinylDNS manages the SOA itself.
+//
+// A desired record set may not share a name with a CNAME, nor appear twice,
+// and a CNAME may not be desired at the zone apex. The SOA and apex NS record
+// sets are never deleted.
+func NewPlan(zone Zone, current, desired []RecordSet, opts PlanOptions) (*Plan, error) {
+	origin := absoluteName(zone.Name)
+
+	desiredByKey := map[string]RecordSet{}
+	desiredTypes := map[string][]string{}
+	wanted := []RecordSet{}
+	for _, rs := range desired {
+		if _, err := ParseRecordType(rs.Type); err != nil {
+			return nil, fmt.Errorf("record set %s: %w", rs.Name, err)
+		}
+		rs.Type = strings.ToUpper(rs.Type)
+		if rs.Type == string(RecordTypeSOA) {
+			continue
+		}
+
+		name := strings.ToLower(relativeOwner(rs.Name, origin))
+		if name == "@" && rs.Type == string(RecordTypeCNAME) {
+			return nil, fmt.Errorf("record set %s %s: a CNAME can't be at the zone apex, which holds the SOA and NS record sets", rs.Name, rs.Type)
+		}
+		key := name + " " + rs.Type
+		if _, ok := desiredByKey[key]; ok {
+			return nil, fmt.Errorf("record set %s %s is desired more than once", rs.Name, rs.Type)
+		}
+		for _, t := range desiredTypes[name] {
+			if t == string(RecordTypeCNAME) || rs.Type == string(RecordTypeCNAME) {
+				return nil, fmt.Errorf("record set %s %s conflicts with desired %s", rs.Name, rs.Type, t)
+			}
+		}
+
+		if relativeOwner(rs.Name, origin) == "@" {
+			rs.Name = zone.Name
+		} else {
+			rs.Name = relativeOwner(rs.Name, origin)
+		}
+		rs.ZoneID = zone.ID
+
+		desiredByKey[key] = rs
+		desiredTypes[name] = append(desiredTypes[name], rs.Type)
+		wanted = append(wanted, rs)
+	}
+
+	plan := &Plan{Zone: zone}
+	currentByKey := map[string]RecordSet{}
+	for _, rs := range current {
+		if rs.Type == string(RecordTypeSOA) {
+			continue
+		}
+
+		name := strings.ToLower(relativeOwner(rs.Name, origin))
+		currentByKey[name+" "+rs.Type] = rs
+		if _, ok := desiredByKey[name+" "+rs.Type]; ok {
+			continue
+		}
+
+		cur := rs
+		switch {
+		case name == "@" && rs.Type == string(RecordTypeNS):
+			// The apex NS record sets delegate the zone, and are left alone.
+		case rs.Type == string(RecordTypeCNAME) && len(desiredTypes[name]) != 0:
+			plan.Changes = append(plan.Changes, PlanChange{Action: PlanActionDelete, Current: &cur, Reason: "conflicts with desired " + desiredTypes[name][0]})
+		case contains(desiredTypes[name], string(RecordTypeCNAME)):
+			plan.Changes = append(plan.Changes, PlanChange{Action: PlanActionDelete, Current: &cur, Reason: "conflicts with desired CNAME"})
+		case opts.Prune:
+			plan.Changes = append(plan.Changes, PlanChange{Action: PlanActionDelete, Current: &cur, Reason: "not in desired state"})
+		}
+	}
+
+	for _, rs := range wanted {
+		want := rs
+		cur, ok := currentByKey[strings.ToLower(relativeOwner(rs.Name, origin))+" "+rs.Type]
+		if !ok {
+			plan.Changes = append(plan.Changes, PlanChange{Action: PlanActionCreate, Desired: &want})
+			continue
+		}
+
+		want.ID = cur.ID
+		want.Name = cur.Name
+		if want.OwnerGroupID == "" {
+			want.OwnerGroupID = cur.OwnerGroupID
+		}
+		action := PlanActionNoOp
+		if len(recordSetDifferences(cur, want)) != 0 {
+			action = PlanActionUpdate
+		}
+		plan.Changes = append(plan.Changes, PlanChange{Action: action, Current: &cur, Desired: &want})
+	}
+
+	if deletes := plan.count(PlanActionDelete); opts.MaxDeletes > 0 && deletes > opts.MaxDeletes {
+		return nil, fmt.Errorf("plan deletes %d record sets, more than the maximum of %d", deletes, opts.MaxDeletes)
+	}
+
+	rank := map[PlanAction]int{PlanActionDelete: 0, PlanActionUpdate: 1, PlanActionCreate: 2, PlanActionNoOp: 3}
+	sort.SliceStable(plan.Changes, func(i, j int) bool {
+		return rank[plan.Changes[i].Action] < rank[plan.Changes[j].Action]
+	})
+
+	return plan, nil
+}
+
+// HasChanges reports whether applying the plan would change anything.
+func (p *Plan) HasChanges() bool {
+	return len(p.Changes) != p.count(PlanActionNoOp)
+}
+
+func (p *Plan) count(action PlanAction) int {
+	n := 0
+	for _, change := range p.Changes {
+		if change.Action == action {
+			n++
+		}
+	}
+
+	return n
+}
+
+// String renders the plan for review, one line per change, followed by
+// the fields that differ for each update and a summary line.
+func (p *Plan) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Plan for zone %s:\n", p.Zone.Name)
+
+	for _, change := range p.Changes {
+		switch change.Action {
+		case PlanActionCreate:
+			rs := change.Desired
+			fmt.Fprintf(&b, "  + %s %s ttl=%d [%s]\n", rs.Name, rs.Type, rs.TTL, strings.Join(formatRecords(*rs), ", "))
+		case PlanActionDelete:
+			rs := change.Current
+			fmt.Fprintf(&b, "  - %s %s", rs.Name, rs.Type)
+			if change.Reason != "" {
+				fmt.Fprintf(&b, " (%s)", change.Reason)
+			}
+			b.WriteString("\n")
+		case PlanActionUpdate:
+			fmt.Fprintf(&b, "  ~ %s %s\n", change.Current.Name, change.Current.Type)
+			for _, d := range recordSetDifferences(*change.Current, *change.Desired) {
+				fmt.Fprintf(&b, "      %s\n", d)
+			}
+		}
+	}
+
+	fmt.Fprintf(&b, "%d to create, %d to update, %d to delete, %d unchanged.\n",
+		p.count(PlanActionCreate), p.count(PlanActionUpdate), p.count(PlanActionDelete), p.count(PlanActionNoOp))
+
+	return b.String()
+}
+
+// RecordSetsApply applies the plan it's passed, in order. Each delete is waited
+// on, per opts, before any update or create is made, so that a conflicting CNAME
+// is gone before its replacement is created. Applying stops at the first error,
+// returning the responses to the changes made so far.
+func (c *Client) RecordSetsApply(plan *Plan, opts *WaitOptions) ([]RecordSetUpdateResponse, error) {
+	return c.RecordSetsApplyWithContext(context.Background(), plan, opts)
+}
+
+// RecordSetsApplyWithContext applies the plan it's passed, in order, using ctx for
+// the lifetime of the requests. Each delete is waited on, per opts, before any
+// update or create is made. Applying stops at the first error, returning the
+// responses to the changes made so far.
+func (c *Client) RecordSetsApplyWithContext(ctx context.Context, plan *Plan, opts *WaitOptions) ([]RecordSetUpdateResponse, error) {
+	responses := []RecordSetUpdateResponse{}
+	deletes := []RecordSetUpdateResponse{}
+
+	for _, change := range plan.Changes {
+		if change.Action != PlanActionDelete && len(deletes) != 0 {
+			for _, d := range deletes {
+				if _, err := c.WaitForRecordSetChange(ctx, plan.Zone.ID, d.RecordSet.ID, d.ChangeID, opts); err != nil {
+					return responses, err
+				}
+			}
+			deletes = nil
+		}
+
+		var resp *RecordSetUpdateResponse
+		var err error
+		switch change.Action {
+		case PlanActionCreate:
+			rs := *change.Desired
+			resp, err = c.RecordSetCreateWithContext(ctx, &rs)
+		case PlanActionUpdate:
+			rs := *change.Desired
+			resp, err = c.RecordSetUpdateWithContext(ctx, &rs)
+		case PlanActionDelete:
+			resp, err = c.RecordSetDeleteWithContext(ctx, plan.Zone.ID, change.Current.ID)
+			if err == nil {
+				if resp.RecordSet.ID == "" {
+					resp.RecordSet.ID = change.Current.ID
+				}
+				deletes = append(deletes, *resp)
+			}
+		default:
+			continue
+		}
+		if err != nil {
+			rs := change.Current
+			if rs == nil {
+				rs = change.Desired
+			}
+			return responses, fmt.Errorf("%s record set %s %s: %w", change.Action, rs.Name, rs.Type, err)
+		}
+		responses = append(responses, *resp)
+	}
+
+	return responses, nil
+}
+
+// recordSetDifferences returns a description of each field that
+// differs between the current and desired state of a record set.
+func recordSetDifferences(current, desired RecordSet) []string {
+	diffs := []string{}
+	if current.TTL != desired.TTL {
+		diffs = append(diffs, fmt.Sprintf("ttl: %d -> %d", current.TTL, desired.TTL))
+	}
+
+	cur, want := formatRecords(current), formatRecords(desired)
+	if strings.Join(cur, "\n") != strings.Join(want, "\n") {
+		diffs = append(diffs, fmt.Sprintf("records: [%s] -> [%s]", strings.Join(cur, ", "), strings.Join(want, ", ")))
+	}
+
+	if current.OwnerGroupID != desired.OwnerGroupID {
+		diffs = append(diffs, fmt.Sprintf("owner group: %q -> %q", current.OwnerGroupID, desired.OwnerGroupID))
+	}
+
+	return diffs
+}
+
+// formatRecords returns the sorted master file presentation of a record set's records.
+func formatRecords(rs RecordSet) []string {
+	records := make([]string, len(rs.Records))
+	for i, r := range rs.Records {
+		records[i] = formatRecord(RecordType(rs.Type), r)
+	}
+	sort.Strings(records)
+
+	return records
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
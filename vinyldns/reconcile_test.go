@@ -0,0 +1,131 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"strings"
+	"testing"
+)
+
+func testPlanCurrent() []RecordSet {
+	soa := NewRecordSet("123", "ok.", RecordTypeSOA, 38400, NewSOARecord("ns1.parent.com.", "hostmaster.parent.com.", 1, 10800, 3600, 604800, 38400))
+	ns := NewRecordSet("123", "ok.", RecordTypeNS, 38400, NewNSRecord("ns1.parent.com."))
+	www := NewRecordSet("123", "www", RecordTypeCNAME, 300, NewCNAMERecord("lb.ok."))
+	mail := NewRecordSet("123", "mail", RecordTypeMX, 300, NewMXRecord(10, "mx1.ok."))
+	api := NewRecordSet("123", "api", RecordTypeA, 300, NewARecord("10.0.0.1"))
+	old := NewRecordSet("123", "old", RecordTypeTXT, 300, NewTXTRecord("old"))
+
+	sets := []RecordSet{soa, ns, www, mail, api, old}
+	for i := range sets {
+		sets[i].ID = "rs-" + sets[i].Name + "-" + sets[i].Type
+	}
+
+	return sets
+}
+
+func testPlanDesired() []RecordSet {
+	return []RecordSet{
+		NewRecordSet("", "www.ok.", RecordTypeA, 300, NewARecord("10.0.0.2")),
+		NewRecordSet("", "mail", RecordTypeMX, 600, NewMXRecord(10, "mx1.ok."), NewMXRecord(20, "mx2.ok.")),
+		NewRecordSet("", "API", RecordTypeA, 300, NewARecord("10.0.0.1")),
+	}
+}
+
+func TestNewPlan(t *testing.T) {
+	plan, err := NewPlan(Zone{ID: "123", Name: "ok."}, testPlanCurrent(), testPlanDesired(), PlanOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions := []string{}
+	for _, change := range plan.Changes {
+		rs := change.Desired
+		if rs == nil {
+			rs = change.Current
+		}
+		actions = append(actions, string(change.Action)+" "+rs.Name+" "+rs.Type)
+	}
+	expected := "delete www CNAME,update mail MX,create www A,no-op api A"
+	if strings.Join(actions, ",") != expected {
+		t.Errorf("Expected %s; got %s", expected, strings.Join(actions, ","))
+	}
+
+	update := plan.Changes[1].Desired
+	if update.ID != "rs-mail-MX" || update.ZoneID != "123" {
+		t.Errorf("Expected the update to carry the current record set's ID and zone; got %+v", update)
+	}
+	if !plan.HasChanges() {
+		t.Error("Expected the plan to have changes")
+	}
+
+	rendered := plan.String()
+	for _, want := range []string{
+		"  - www CNAME (conflicts with desired A)\n",
+		"  ~ mail MX\n      ttl: 300 -> 600\n      records: [10 mx1.ok.] -> [10 mx1.ok., 20 mx2.ok.]\n",
+		"  + www A ttl=300 [10.0.0.2]\n",
+		"1 to create, 1 to update, 1 to delete, 1 unchanged.\n",
+	} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected the rendered plan to contain %q; got:\n%s", want, rendered)
+		}
+	}
+}
+
+func TestNewPlanPrune(t *testing.T) {
+	plan, err := NewPlan(Zone{ID: "123", Name: "ok."}, testPlanCurrent(), testPlanDesired(), PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deletes := []string{}
+	for _, change := range plan.Changes {
+		if change.Action == PlanActionDelete {
+			deletes = append(deletes, change.Current.Name+" "+change.Current.Type)
+		}
+	}
+	if strings.Join(deletes, ",") != "www CNAME,old TXT" {
+		t.Errorf("Expected the CNAME and unmanaged TXT, but not the SOA or apex NS, to be deleted; got %v", deletes)
+	}
+
+	if _, err := NewPlan(Zone{ID: "123", Name: "ok."}, testPlanCurrent(), testPlanDesired(), PlanOptions{Prune: true, MaxDeletes: 1}); err == nil {
+		t.Error("Expected an error for a plan exceeding MaxDeletes")
+	}
+}
+
+func TestNewPlanInvalidDesired(t *testing.T) {
+	tests := [][]RecordSet{
+		{
+			NewRecordSet("", "www", RecordTypeA, 300, NewARecord("10.0.0.1")),
+			NewRecordSet("", "WWW.ok.", RecordTypeA, 300, NewARecord("10.0.0.2")),
+		},
+		{
+			NewRecordSet("", "www", RecordTypeCNAME, 300, NewCNAMERecord("lb.ok.")),
+			NewRecordSet("", "www", RecordTypeTXT, 300, NewTXTRecord("text")),
+		},
+		{
+			{Name: "www", Type: "HINFO"},
+		},
+		{
+			NewRecordSet("", "@", RecordTypeCNAME, 300, NewCNAMERecord("lb.ok.")),
+		},
+		{
+			NewRecordSet("", "OK.", RecordTypeCNAME, 300, NewCNAMERecord("lb.ok.")),
+		},
+	}
+
+	for _, desired := range tests {
+		if _, err := NewPlan(Zone{ID: "123", Name: "ok."}, nil, desired, PlanOptions{}); err == nil {
+			t.Errorf("Expected an error planning %+v", desired)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// RecordType is a DNS record type supported by VinylDNS.
+type RecordType string
+
+const (
+	// RecordTypeA represents an A record.
+	RecordTypeA RecordType = "A"
+	// RecordTypeAAAA represents an AAAA record.
+	RecordTypeAAAA RecordType = "AAAA"
+	// RecordTypeCNAME represents a CNAME record.
+	RecordTypeCNAME RecordType = "CNAME"
+	// RecordTypeDS represents a DS record.
+	RecordTypeDS RecordType = "DS"
+	// RecordTypeMX represents an MX record.
+	RecordTypeMX RecordType = "MX"
+	// RecordTypeNAPTR represents a NAPTR record.
+	RecordTypeNAPTR RecordType = "NAPTR"
+	// RecordTypeNS represents an NS record.
+	RecordTypeNS RecordType = "NS"
+	// RecordTypePTR represents a PTR record.
+	RecordTypePTR RecordType = "PTR"
+	// RecordTypeSOA represents an SOA record.
+	RecordTypeSOA RecordType = "SOA"
+	// RecordTypeSPF represents an SPF record.
+	RecordTypeSPF RecordType = "SPF"
+	// RecordTypeSRV represents an SRV record.
+	RecordTypeSRV RecordType = "SRV"
+	// RecordTypeSSHFP represents an SSHFP record.
+	RecordTypeSSHFP RecordType = "SSHFP"
+	// RecordTypeTXT represents a TXT record.
+	RecordTypeTXT RecordType = "TXT"
+)
+
+// recordTypeFields lists the JSON fields of a Record that apply to each record type.
+var recordTypeFields = map[RecordType][]string{
+	RecordTypeA:     {"address"},
+	RecordTypeAAAA:  {"address"},
+	RecordTypeCNAME: {"cname"},
+	RecordTypeDS:    {"keytag", "algorithm", "digesttype", "digest"},
+	RecordTypeMX:    {"preference", "exchange"},
+	RecordTypeNAPTR: {"order", "preference", "flags", "service", "regexp", "replacement"},
+	RecordTypeNS:    {"nsdname"},
+	RecordTypePTR:   {"ptrdname"},
+	RecordTypeSOA:   {"mname", "rname", "serial", "refresh", "retry", "expire", "minimum"},
+	RecordTypeSPF:   {"text"},
+	RecordTypeSRV:   {"priority", "weight", "port", "target"},
+	RecordTypeSSHFP: {"algorithm", "type", "fingerprint"},
+	RecordTypeTXT:   {"text"},
+}
+
+// RecordTypes returns every record type supported by VinylDNS.
+func RecordTypes() []RecordType {
+	return []RecordType{
+		RecordTypeA,
+		RecordTypeAAAA,
+		RecordTypeCNAME,
+		RecordTypeDS,
+		RecordTypeMX,
+		RecordTypeNAPTR,
+		RecordTypeNS,
+		RecordTypePTR,
+		RecordTypeSOA,
+		RecordTypeSPF,
+		RecordTypeSRV,
+		RecordTypeSSHFP,
+		RecordTypeTXT,
+	}
+}
+
+// ParseRecordType returns the RecordType named by s, ignoring case.
+func ParseRecordType(s string) (RecordType, error) {
+	t := RecordType(strings.ToUpper(s))
+	if !t.Valid() {
+		return "", fmt.Errorf("unsupported record type %q", s)
+	}
+
+	return t, nil
+}
+
+// Valid reports whether t is a record type supported by VinylDNS.
+func (t RecordType) Valid() bool {
+	_, ok := recordTypeFields[t]
+	return ok
+}
+
+func (t RecordType) String() string {
+	return string(t)
+}
+
+// NewRecordSet returns a RecordSet of the type, and with the records, it's passed.
+func NewRecordSet(zoneID, name string, recordType RecordType, ttl int, records ...Record) RecordSet {
+	return RecordSet{
+		ZoneID:  zoneID,
+		Name:    name,
+		Type:    string(recordType),
+		TTL:     ttl,
+		Records: records,
+	}
+}
+
+// NewARecord returns an A record.
+func NewARecord(address string) Record {
+	return Record{Address: address}
+}
+
+// NewAAAARecord returns an AAAA record.
+func NewAAAARecord(address string) Record {
+	return Record{Address: address}
+}
+
+// NewCNAMERecord returns a CNAME record.
+func NewCNAMERecord(cname string) Record {
+	return Record{CName: cname}
+}
+
+// NewDSRecord returns a DS record.
+func NewDSRecord(keyTag, algorithm, digestType int, digest string) Record {
+	return Record{KeyTag: keyTag, Algorithm: algorithm, DigestType: digestType, Digest: digest}
+}
+
+// NewMXRecord returns an MX record.
+func NewMXRecord(preference int, exchange string) Record {
+	return Record{Preference: preference, Exchange: exchange}
+}
+
+// NewNAPTRRecord returns a NAPTR record.
+func NewNAPTRRecord(order, preference int, flags, service, regexp, replacement string) Record {
+	return Record{
+		Order:       order,
+		Preference:  preference,
+		Flags:       flags,
+		Service:     service,
+		Regexp:      regexp,
+		Replacement: replacement,
+	}
+}
+
+// NewNSRecord returns an NS record.
+func NewNSRecord(nsdname string) Record {
+	return Record{NSDName: nsdname}
+}
+
+// NewPTRRecord returns a PTR record.
+func NewPTRRecord(ptrdname string) Record {
+	return Record{PTRDName: ptrdname}
+}
+
+// NewSOARecord returns an SOA record.
+func NewSOARecord(mname, rname string, serial, refresh, retry, expire, minimum int) Record {
+	return Record{
+		MName:   mname,
+		RName:   rname,
+		Serial:  serial,
+		Refresh: refresh,
+		Retry:   retry,
+		Expire:  expire,
+		Minimum: minimum,
+	}
+}
+
+// NewSPFRecord returns an SPF record.
+func NewSPFRecord(text string) Record {
+	return Record{Text: text}
+}
+
+// NewSRVRecord returns an SRV record.
+func NewSRVRecord(priority, weight, port int, target string) Record {
+	return Record{Priority: priority, Weight: weight, Port: port, Target: target}
+}
+
+// NewSSHFPRecord returns an SSHFP record.
+func NewSSHFPRecord(algorithm, fingerprintType int, fingerprint string) Record {
+	return Record{Algorithm: algorithm, FingerprintType: fingerprintType, Fingerprint: fingerprint}
+}
+
+// NewTXTRecord returns a TXT record.
+func NewTXTRecord(text string) Record {
+	return Record{Text: text}
+}
+
+// field returns the value of the Record field whose JSON name it's passed.
+func (r Record) field(name string) interface{} {
+	switch name {
+	case "address":
+		return r.Address
+	case "cname":
+		return r.CName
+	case "preference":
+		return r.Preference
+	case "exchange":
+		return r.Exchange
+	case "nsdname":
+		return r.NSDName
+	case "ptrdname":
+		return r.PTRDName
+	case "mname":
+		return r.MName
+	case "rname":
+		return r.RName
+	case "serial":
+		return r.Serial
+	case "refresh":
+		return r.Refresh
+	case "retry":
+		return r.Retry
+	case "expire":
+		return r.Expire
+	case "minimum":
+		return r.Minimum
+	case "text":
+		return r.Text
+	case "priority":
+		return r.Priority
+	case "weight":
+		return r.Weight
+	case "port":
+		return r.Port
+	case "target":
+		return r.Target
+	case "algorithm":
+		return r.Algorithm
+	case "type":
+		return r.FingerprintType
+	case "fingerprint":
+		return r.Fingerprint
+	case "keytag":
+		return r.KeyTag
+	case "digesttype":
+		return r.DigestType
+	case "digest":
+		return r.Digest
+	case "order":
+		return r.Order
+	case "flags":
+		return r.Flags
+	case "service":
+		return r.Service
+	case "regexp":
+		return r.Regexp
+	case "replacement":
+		return r.Replacement
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes the record set, encoding each of its records with
+// exactly the fields of the record set's type. Unlike the omitempty encoding
+// of a lone Record, this preserves meaningful zero values such as an MX
+// preference or SRV weight of 0.
+func (rs RecordSet) MarshalJSON() ([]byte, error) {
+	type recordSet RecordSet
+
+	fields, ok := recordTypeFields[RecordType(rs.Type)]
+	if !ok || rs.Records == nil {
+		return json.Marshal(recordSet(rs))
+	}
+
+	records := make([]map[string]interface{}, len(rs.Records))
+	for i, r := range rs.Records {
+		records[i] = map[string]interface{}{}
+		for _, f := range fields {
+			records[i][f] = r.field(f)
+		}
+	}
+
+	return json.Marshal(struct {
+		recordSet
+		Records []map[string]interface{} `json:"records"`
+	}{recordSet(rs), records})
+}
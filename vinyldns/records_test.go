@@ -0,0 +1,86 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestParseRecordType(t *testing.T) {
+	rt, err := ParseRecordType("naptr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rt != RecordTypeNAPTR {
+		t.Errorf("Expected NAPTR; got %s", rt)
+	}
+
+	if _, err := ParseRecordType("HINFO"); err == nil {
+		t.Error("Expected an error parsing an unsupported record type")
+	}
+
+	for _, rt := range RecordTypes() {
+		if !rt.Valid() {
+			t.Errorf("Expected %s to be valid", rt)
+		}
+	}
+}
+
+func TestRecordSetMarshalJSONKeepsZeroValues(t *testing.T) {
+	rs := NewRecordSet("123", "srv", RecordTypeSRV, 300, NewSRVRecord(0, 0, 5060, "sip.ok."))
+
+	b, err := json.Marshal(rs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := struct {
+		Type    string                   `json:"type"`
+		Records []map[string]interface{} `json:"records"`
+	}{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	if got.Type != "SRV" || len(got.Records) != 1 {
+		t.Fatalf("Unexpected record set JSON %s", b)
+	}
+	record := got.Records[0]
+	if len(record) != 4 || record["priority"] != float64(0) || record["weight"] != float64(0) || record["target"] != "sip.ok." {
+		t.Errorf("Expected exactly the SRV fields, including zero priority and weight; got %s", b)
+	}
+}
+
+func TestRecordSetJSONRoundTrip(t *testing.T) {
+	sets := []RecordSet{
+		NewRecordSet("123", "ds", RecordTypeDS, 300, NewDSRecord(60485, 5, 1, "2BB183AF5F22588179A53B0A98631FAD1A292118")),
+		NewRecordSet("123", "naptr", RecordTypeNAPTR, 300, NewNAPTRRecord(100, 10, "U", "E2U+sip", "!^.*$!sip:info@ok!", ".")),
+		NewRecordSet("123", "sshfp", RecordTypeSSHFP, 300, NewSSHFPRecord(2, 1, "123456789ABCDEF67890123456789ABCDEF67890")),
+		NewRecordSet("123", "mx", RecordTypeMX, 300, NewMXRecord(0, "mail.ok.")),
+	}
+
+	for _, rs := range sets {
+		b, err := json.Marshal(rs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded := RecordSet{}
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if decoded.Records[0] != rs.Records[0] {
+			t.Errorf("Expected %s record to survive a round trip; got %+v from %s", rs.Type, decoded.Records[0], b)
+		}
+	}
+}
@@ -13,9 +13,11 @@ limitations under the License.
 package vinyldns
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"iter"
 )
 
 // RecordSetLimit is the highest number of records the vinyldns server will allow at once
@@ -23,55 +25,78 @@ import (
 const RecordSetLimit = 100
 
 // RecordSetCollector creates a function to retrieve the next set of recordsets.
-// To retrieve *all* recordsets, call that function repeatedly until err == // io.EOF
+// To retrieve *all* recordsets, call that function repeatedly until err == io.EOF,
+// which is returned along with the last of them.
 func (c *Client) RecordSetCollector(zoneID string, limit int) (func() ([]RecordSet, error), error) {
+	return c.RecordSetCollectorWithContext(context.Background(), zoneID, limit)
+}
+
+// RecordSetCollectorWithContext is RecordSetCollector with a context.Context
+// that is applied to every request the returned function makes.
+func (c *Client) RecordSetCollectorWithContext(ctx context.Context, zoneID string, limit int) (func() ([]RecordSet, error), error) {
+	return c.RecordSetCollectorFilteredWithContext(ctx, zoneID, ZoneRecordSetFilter{MaxItems: limit})
+}
+
+// RecordSetCollectorFiltered is RecordSetCollector for the record sets matching the
+// ZoneRecordSetFilter criteria passed, whose MaxItems is the collector's limit.
+func (c *Client) RecordSetCollectorFiltered(zoneID string, filter ZoneRecordSetFilter) (func() ([]RecordSet, error), error) {
+	return c.RecordSetCollectorFilteredWithContext(context.Background(), zoneID, filter)
+}
+
+// RecordSetCollectorFilteredWithContext is RecordSetCollectorFiltered with a
+// context.Context that is applied to every request the returned function makes.
+func (c *Client) RecordSetCollectorFilteredWithContext(ctx context.Context, zoneID string, filter ZoneRecordSetFilter) (func() ([]RecordSet, error), error) {
+	limit := filter.MaxItems
 	if limit > RecordSetLimit {
 		return nil, fmt.Errorf("Limit must be zero or not greater than %d", RecordSetLimit)
 	}
 
-	var nextID string
 	var recordSets []RecordSet
 	var err error
 
 	return func() ([]RecordSet, error) {
-		if err != nil {
-			return nil, err
-		}
-
-		for {
-			rss := &RecordSetsResponse{}
-			err = resourceRequest(c, recordSetsListEP(c, zoneID, ListFilter{
-				StartFrom: nextID,
-				MaxItems:  limit,
-			}), "GET", nil, rss)
+		for err == nil {
+			var rss *RecordSetsResponse
+			rss, err = c.recordSetsList(ctx, zoneID, filter)
 			if err != nil {
 				return nil, err
 			}
 			recordSets = append(recordSets, rss.RecordSets...)
 
-			nextID = rss.NextID
-			if len(nextID) == 0 {
+			filter.StartFrom = rss.NextID
+			if len(filter.StartFrom) == 0 {
 				// keep from trying to get more records
 				err = io.EOF
-
-				break
 			}
 		}
+		if err != io.EOF {
+			return nil, err
+		}
 
-		// return at most `limit` records and remove those returned from recordSets
+		// return at most `limit` records and remove those returned from recordSets,
+		// returning io.EOF along with the last of them
 		max := limit
 		if max == 0 || max > len(recordSets) {
 			max = len(recordSets)
 		}
 		r := recordSets[:max]
 		recordSets = recordSets[max:]
-		return r, err
+		if len(recordSets) != 0 {
+			return r, nil
+		}
+		return r, io.EOF
 	}, nil
 }
 
 // RecordSets retrieves a list of RecordSets from a Zone.
 func (c *Client) RecordSets(id string) ([]RecordSet, error) {
-	collector, err := c.RecordSetCollector(id, 0)
+	return c.RecordSetsWithContext(context.Background(), id)
+}
+
+// RecordSetsWithContext retrieves a list of RecordSets from a Zone,
+// using ctx for the lifetime of each request.
+func (c *Client) RecordSetsWithContext(ctx context.Context, id string) ([]RecordSet, error) {
+	collector, err := c.RecordSetCollectorWithContext(ctx, id, 0)
 	if err != nil {
 		return nil, err
 	}
@@ -95,6 +120,26 @@ func (c *Client) RecordSets(id string) ([]RecordSet, error) {
 // the specified zone with the ListFilter criteria passed.
 // It handles paging through results on the user's behalf.
 func (c *Client) RecordSetsListAll(zoneID string, filter ListFilter) ([]RecordSet, error) {
+	return c.RecordSetsListAllWithContext(context.Background(), zoneID, filter)
+}
+
+// RecordSetsListAllWithContext is RecordSetsListAll with a context.Context
+// that is applied to each page request.
+func (c *Client) RecordSetsListAllWithContext(ctx context.Context, zoneID string, filter ListFilter) ([]RecordSet, error) {
+	return c.RecordSetsListAllFilteredWithContext(ctx, zoneID, zoneRecordSetFilter(filter))
+}
+
+// RecordSetsListAllFiltered retrieves the complete list of record sets from the
+// specified zone with the ZoneRecordSetFilter criteria passed, such as its
+// record sets of a type or owned by a group, sorted by NameSort.
+// It handles paging through results on the user's behalf.
+func (c *Client) RecordSetsListAllFiltered(zoneID string, filter ZoneRecordSetFilter) ([]RecordSet, error) {
+	return c.RecordSetsListAllFilteredWithContext(context.Background(), zoneID, filter)
+}
+
+// RecordSetsListAllFilteredWithContext is RecordSetsListAllFiltered with a
+// context.Context that is applied to each page request.
+func (c *Client) RecordSetsListAllFilteredWithContext(ctx context.Context, zoneID string, filter ZoneRecordSetFilter) ([]RecordSet, error) {
 	if filter.MaxItems > 100 {
 		return nil, fmt.Errorf("MaxItems must be between 1 and 100")
 	}
@@ -102,7 +147,7 @@ func (c *Client) RecordSetsListAll(zoneID string, filter ListFilter) ([]RecordSe
 	rss := []RecordSet{}
 
 	for {
-		resp, err := c.recordSetsList(zoneID, filter)
+		resp, err := c.recordSetsList(ctx, zoneID, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -116,15 +161,55 @@ func (c *Client) RecordSetsListAll(zoneID string, filter ListFilter) ([]RecordSe
 	}
 }
 
+// RecordSetsPager returns a Pager over the record sets in the zone whose ID it's passed,
+// matching the ListFilter criteria passed and fetching pages lazily starting at filter.StartFrom.
+func (c *Client) RecordSetsPager(ctx context.Context, zoneID string, filter ListFilter) *Pager[RecordSet, string] {
+	return c.RecordSetsPagerFiltered(ctx, zoneID, zoneRecordSetFilter(filter))
+}
+
+// RecordSetsIter returns an iterator over the record sets in the zone whose ID it's passed.
+func (c *Client) RecordSetsIter(ctx context.Context, zoneID string, filter ListFilter) iter.Seq2[RecordSet, error] {
+	return c.RecordSetsPager(ctx, zoneID, filter).All()
+}
+
+// RecordSetsPagerFiltered returns a Pager over the record sets in the zone whose ID
+// it's passed, matching the ZoneRecordSetFilter criteria passed, such as its record
+// sets of a type or owned by a group, and fetching pages lazily starting at filter.StartFrom.
+func (c *Client) RecordSetsPagerFiltered(ctx context.Context, zoneID string, filter ZoneRecordSetFilter) *Pager[RecordSet, string] {
+	return NewPager(ctx, filter.StartFrom, func(ctx context.Context, startFrom string) ([]RecordSet, string, error) {
+		if filter.MaxItems > 100 {
+			return nil, "", fmt.Errorf("MaxItems must be between 1 and 100")
+		}
+		filter.StartFrom = startFrom
+		resp, err := c.recordSetsList(ctx, zoneID, filter)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.RecordSets, resp.NextID, nil
+	})
+}
+
+// RecordSetsIterFiltered returns an iterator over the record sets in the zone whose ID
+// it's passed, matching the ZoneRecordSetFilter criteria passed.
+func (c *Client) RecordSetsIterFiltered(ctx context.Context, zoneID string, filter ZoneRecordSetFilter) iter.Seq2[RecordSet, error] {
+	return c.RecordSetsPagerFiltered(ctx, zoneID, filter).All()
+}
+
 // RecordSetsGlobal retrieves the list of record sets with the GlobalListFilter criteria passed, across all zones. It
 // respects the GlobalListFilter.MaxItems value and returns no more records than requested. The result is the set of
 // records plus the "nextID" if available. This can be used as the GlobalListFilter.StartFrom value to handle
 // pagination manually
 func (c *Client) RecordSetsGlobal(filter GlobalListFilter) ([]RecordSet, string, error) {
+	return c.RecordSetsGlobalWithContext(context.Background(), filter)
+}
+
+// RecordSetsGlobalWithContext is RecordSetsGlobal with a context.Context
+// controlling the lifetime of the request.
+func (c *Client) RecordSetsGlobalWithContext(ctx context.Context, filter GlobalListFilter) ([]RecordSet, string, error) {
 	if filter.MaxItems > RecordSetLimit {
 		return nil, "", fmt.Errorf("MaxItems must be between 1 and %d", RecordSetLimit)
 	}
-	resp, err := c.recordSetsGlobalList(filter)
+	resp, err := c.recordSetsGlobalList(ctx, filter)
 	if err != nil {
 		return nil, "", err
 	}
@@ -135,6 +220,12 @@ func (c *Client) RecordSetsGlobal(filter GlobalListFilter) ([]RecordSet, string,
 // GlobalListFilter criteria passed, across all zones.
 // It handles paging through results on the user's behalf.
 func (c *Client) RecordSetsGlobalListAll(filter GlobalListFilter) ([]RecordSet, error) {
+	return c.RecordSetsGlobalListAllWithContext(context.Background(), filter)
+}
+
+// RecordSetsGlobalListAllWithContext is RecordSetsGlobalListAll with a context.Context
+// that is applied to each page request.
+func (c *Client) RecordSetsGlobalListAllWithContext(ctx context.Context, filter GlobalListFilter) ([]RecordSet, error) {
 	if filter.MaxItems > RecordSetLimit {
 		return nil, fmt.Errorf("MaxItems must be between 1 and %d", RecordSetLimit)
 	}
@@ -142,7 +233,7 @@ func (c *Client) RecordSetsGlobalListAll(filter GlobalListFilter) ([]RecordSet,
 	rss := []RecordSet{}
 
 	for {
-		resp, err := c.recordSetsGlobalList(filter)
+		resp, err := c.recordSetsGlobalList(ctx, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -156,10 +247,39 @@ func (c *Client) RecordSetsGlobalListAll(filter GlobalListFilter) ([]RecordSet,
 	}
 }
 
+// RecordSetsGlobalPager returns a Pager over the record sets matching the GlobalListFilter
+// criteria passed, across all zones, fetching pages lazily starting at filter.StartFrom.
+// Its Cursor can be persisted to resume a long scan later.
+func (c *Client) RecordSetsGlobalPager(ctx context.Context, filter GlobalListFilter) *Pager[RecordSet, string] {
+	return NewPager(ctx, filter.StartFrom, func(ctx context.Context, startFrom string) ([]RecordSet, string, error) {
+		if filter.MaxItems > RecordSetLimit {
+			return nil, "", fmt.Errorf("MaxItems must be between 1 and %d", RecordSetLimit)
+		}
+		filter.StartFrom = startFrom
+		resp, err := c.recordSetsGlobalList(ctx, filter)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.RecordSets, resp.NextID, nil
+	})
+}
+
+// RecordSetsGlobalIter returns an iterator over the record sets matching the
+// GlobalListFilter criteria passed, across all zones.
+func (c *Client) RecordSetsGlobalIter(ctx context.Context, filter GlobalListFilter) iter.Seq2[RecordSet, error] {
+	return c.RecordSetsGlobalPager(ctx, filter).All()
+}
+
 // RecordSet retrieves the record matching the Zone ID and RecordSet ID it's passed.
 func (c *Client) RecordSet(zoneID, recordSetID string) (RecordSet, error) {
+	return c.RecordSetWithContext(context.Background(), zoneID, recordSetID)
+}
+
+// RecordSetWithContext retrieves the record matching the Zone ID and RecordSet ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetWithContext(ctx context.Context, zoneID, recordSetID string) (RecordSet, error) {
 	rs := &RecordSetResponse{}
-	err := resourceRequest(c, recordSetEP(c, zoneID, recordSetID), "GET", nil, rs)
+	err := resourceRequest(ctx, c, recordSetEP(c, zoneID, recordSetID), "GET", nil, rs)
 	if err != nil {
 		return RecordSet{}, err
 	}
@@ -169,8 +289,14 @@ func (c *Client) RecordSet(zoneID, recordSetID string) (RecordSet, error) {
 
 // RecordSetCount retrieves the count of record sets in a zone.
 func (c *Client) RecordSetCount(zoneID string) (RecordSetCount, error) {
+	return c.RecordSetCountWithContext(context.Background(), zoneID)
+}
+
+// RecordSetCountWithContext retrieves the count of record sets in a zone,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetCountWithContext(ctx context.Context, zoneID string) (RecordSetCount, error) {
 	count := &RecordSetCount{}
-	err := resourceRequest(c, recordSetCountEP(c, zoneID), "GET", nil, count)
+	err := resourceRequest(ctx, c, recordSetCountEP(c, zoneID), "GET", nil, count)
 	if err != nil {
 		return RecordSetCount{}, err
 	}
@@ -180,12 +306,18 @@ func (c *Client) RecordSetCount(zoneID string) (RecordSetCount, error) {
 
 // RecordSetCreate creates the RecordSet it's passed in the Zone whose ID it's passed.
 func (c *Client) RecordSetCreate(rs *RecordSet) (*RecordSetUpdateResponse, error) {
+	return c.RecordSetCreateWithContext(context.Background(), rs)
+}
+
+// RecordSetCreateWithContext creates the RecordSet it's passed in the Zone whose ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetCreateWithContext(ctx context.Context, rs *RecordSet) (*RecordSetUpdateResponse, error) {
 	rsJSON, err := json.Marshal(rs)
 	if err != nil {
 		return nil, err
 	}
 	var resource = &RecordSetUpdateResponse{}
-	err = resourceRequest(c, recordSetsEP(c, rs.ZoneID), "POST", rsJSON, resource)
+	err = resourceRequest(ctx, c, recordSetsEP(c, rs.ZoneID), "POST", rsJSON, resource)
 	if err != nil {
 		return &RecordSetUpdateResponse{}, err
 	}
@@ -195,12 +327,18 @@ func (c *Client) RecordSetCreate(rs *RecordSet) (*RecordSetUpdateResponse, error
 
 // RecordSetUpdate updates the RecordSet matching the Zone ID and RecordSetID it's passed.
 func (c *Client) RecordSetUpdate(rs *RecordSet) (*RecordSetUpdateResponse, error) {
+	return c.RecordSetUpdateWithContext(context.Background(), rs)
+}
+
+// RecordSetUpdateWithContext updates the RecordSet matching the Zone ID and RecordSetID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetUpdateWithContext(ctx context.Context, rs *RecordSet) (*RecordSetUpdateResponse, error) {
 	rsJSON, err := json.Marshal(rs)
 	if err != nil {
 		return nil, err
 	}
 	var resource = &RecordSetUpdateResponse{}
-	err = resourceRequest(c, recordSetEP(c, rs.ZoneID, rs.ID), "PUT", rsJSON, resource)
+	err = resourceRequest(ctx, c, recordSetEP(c, rs.ZoneID, rs.ID), "PUT", rsJSON, resource)
 	if err != nil {
 		return &RecordSetUpdateResponse{}, err
 	}
@@ -210,25 +348,49 @@ func (c *Client) RecordSetUpdate(rs *RecordSet) (*RecordSetUpdateResponse, error
 
 // RecordSetOwnershipTransferRequest requests ownership transfer for a record set.
 func (c *Client) RecordSetOwnershipTransferRequest(rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error) {
-	return c.recordSetOwnershipTransfer(rs, OwnershipTransferStatusRequested, requestedOwnerGroupID, false)
+	return c.RecordSetOwnershipTransferRequestWithContext(context.Background(), rs, requestedOwnerGroupID)
+}
+
+// RecordSetOwnershipTransferRequestWithContext requests ownership transfer for a record set,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetOwnershipTransferRequestWithContext(ctx context.Context, rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error) {
+	return c.recordSetOwnershipTransfer(ctx, rs, OwnershipTransferStatusRequested, requestedOwnerGroupID, false)
 }
 
 // RecordSetOwnershipTransferApprove approves a pending ownership transfer request.
 func (c *Client) RecordSetOwnershipTransferApprove(rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error) {
-	return c.recordSetOwnershipTransfer(rs, OwnershipTransferStatusManuallyApproved, requestedOwnerGroupID, true)
+	return c.RecordSetOwnershipTransferApproveWithContext(context.Background(), rs, requestedOwnerGroupID)
+}
+
+// RecordSetOwnershipTransferApproveWithContext approves a pending ownership transfer request,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetOwnershipTransferApproveWithContext(ctx context.Context, rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error) {
+	return c.recordSetOwnershipTransfer(ctx, rs, OwnershipTransferStatusManuallyApproved, requestedOwnerGroupID, true)
 }
 
 // RecordSetOwnershipTransferReject rejects a pending ownership transfer request.
 func (c *Client) RecordSetOwnershipTransferReject(rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error) {
-	return c.recordSetOwnershipTransfer(rs, OwnershipTransferStatusManuallyRejected, requestedOwnerGroupID, false)
+	return c.RecordSetOwnershipTransferRejectWithContext(context.Background(), rs, requestedOwnerGroupID)
+}
+
+// RecordSetOwnershipTransferRejectWithContext rejects a pending ownership transfer request,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetOwnershipTransferRejectWithContext(ctx context.Context, rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error) {
+	return c.recordSetOwnershipTransfer(ctx, rs, OwnershipTransferStatusManuallyRejected, requestedOwnerGroupID, false)
 }
 
 // RecordSetOwnershipTransferCancel cancels a pending ownership transfer request.
 func (c *Client) RecordSetOwnershipTransferCancel(rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error) {
-	return c.recordSetOwnershipTransfer(rs, OwnershipTransferStatusCancelled, requestedOwnerGroupID, false)
+	return c.RecordSetOwnershipTransferCancelWithContext(context.Background(), rs, requestedOwnerGroupID)
+}
+
+// RecordSetOwnershipTransferCancelWithContext cancels a pending ownership transfer request,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetOwnershipTransferCancelWithContext(ctx context.Context, rs *RecordSet, requestedOwnerGroupID string) (*RecordSetUpdateResponse, error) {
+	return c.recordSetOwnershipTransfer(ctx, rs, OwnershipTransferStatusCancelled, requestedOwnerGroupID, false)
 }
 
-func (c *Client) recordSetOwnershipTransfer(rs *RecordSet, status OwnershipTransferStatus, requestedOwnerGroupID string, updateOwnerGroup bool) (*RecordSetUpdateResponse, error) {
+func (c *Client) recordSetOwnershipTransfer(ctx context.Context, rs *RecordSet, status OwnershipTransferStatus, requestedOwnerGroupID string, updateOwnerGroup bool) (*RecordSetUpdateResponse, error) {
 	if rs == nil {
 		return nil, fmt.Errorf("record set is required")
 	}
@@ -244,13 +406,19 @@ func (c *Client) recordSetOwnershipTransfer(rs *RecordSet, status OwnershipTrans
 		RequestedOwnerGroupID:   requestedOwnerGroupID,
 	}
 
-	return c.RecordSetUpdate(&copyRS)
+	return c.RecordSetUpdateWithContext(ctx, &copyRS)
 }
 
 // RecordSetDelete deletes the RecordSet matching the Zone ID and RecordSet ID it's passed.
 func (c *Client) RecordSetDelete(zoneID, recordSetID string) (*RecordSetUpdateResponse, error) {
+	return c.RecordSetDeleteWithContext(context.Background(), zoneID, recordSetID)
+}
+
+// RecordSetDeleteWithContext deletes the RecordSet matching the Zone ID and RecordSet ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetDeleteWithContext(ctx context.Context, zoneID, recordSetID string) (*RecordSetUpdateResponse, error) {
 	resource := &RecordSetUpdateResponse{}
-	err := resourceRequest(c, recordSetEP(c, zoneID, recordSetID), "DELETE", nil, resource)
+	err := resourceRequest(ctx, c, recordSetEP(c, zoneID, recordSetID), "DELETE", nil, resource)
 	if err != nil {
 		return &RecordSetUpdateResponse{}, err
 	}
@@ -260,8 +428,14 @@ func (c *Client) RecordSetDelete(zoneID, recordSetID string) (*RecordSetUpdateRe
 
 // RecordSetChanges retrieves the RecordSetChanges response for the Zone and ListFilter it's passed.
 func (c *Client) RecordSetChanges(zoneID string, f ListFilterRecordSetChanges) (*RecordSetChanges, error) {
+	return c.RecordSetChangesWithContext(context.Background(), zoneID, f)
+}
+
+// RecordSetChangesWithContext retrieves the RecordSetChanges response for the Zone and ListFilter it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetChangesWithContext(ctx context.Context, zoneID string, f ListFilterRecordSetChanges) (*RecordSetChanges, error) {
 	rsc := &RecordSetChanges{}
-	err := resourceRequest(c, recordSetChangesEP(c, zoneID, f), "GET", nil, rsc)
+	err := resourceRequest(ctx, c, recordSetChangesEP(c, zoneID, f), "GET", nil, rsc)
 	if err != nil {
 		return &RecordSetChanges{}, err
 	}
@@ -271,12 +445,18 @@ func (c *Client) RecordSetChanges(zoneID string, f ListFilterRecordSetChanges) (
 
 // RecordSetChangeHistory retrieves history for a record set.
 func (c *Client) RecordSetChangeHistory(f RecordSetChangeHistoryFilter) (*RecordSetChanges, error) {
+	return c.RecordSetChangeHistoryWithContext(context.Background(), f)
+}
+
+// RecordSetChangeHistoryWithContext retrieves history for a record set,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetChangeHistoryWithContext(ctx context.Context, f RecordSetChangeHistoryFilter) (*RecordSetChanges, error) {
 	if f.ZoneID == "" || f.FQDN == "" || f.RecordType == "" {
 		return nil, fmt.Errorf("zone ID, FQDN, and record type are required")
 	}
 
 	rsc := &RecordSetChanges{}
-	err := resourceRequest(c, recordSetChangeHistoryEP(c, f), "GET", nil, rsc)
+	err := resourceRequest(ctx, c, recordSetChangeHistoryEP(c, f), "GET", nil, rsc)
 	if err != nil {
 		return &RecordSetChanges{}, err
 	}
@@ -287,6 +467,12 @@ func (c *Client) RecordSetChangeHistory(f RecordSetChangeHistoryFilter) (*Record
 // RecordSetChangesListAll retrieves the complete list of record set changes for the Zone ListFilter criteria passed.
 // Handles paging through results on the user's behalf.
 func (c *Client) RecordSetChangesListAll(zoneID string, filter ListFilterRecordSetChanges) ([]RecordSetChange, error) {
+	return c.RecordSetChangesListAllWithContext(context.Background(), zoneID, filter)
+}
+
+// RecordSetChangesListAllWithContext is RecordSetChangesListAll with a context.Context
+// that is applied to each page request.
+func (c *Client) RecordSetChangesListAllWithContext(ctx context.Context, zoneID string, filter ListFilterRecordSetChanges) ([]RecordSetChange, error) {
 	if filter.MaxItems > 100 {
 		return nil, fmt.Errorf("MaxItems must be between 1 and 100")
 	}
@@ -294,7 +480,7 @@ func (c *Client) RecordSetChangesListAll(zoneID string, filter ListFilterRecordS
 	rsc := []RecordSetChange{}
 
 	for {
-		resp, err := c.RecordSetChanges(zoneID, filter)
+		resp, err := c.RecordSetChangesWithContext(ctx, zoneID, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -308,11 +494,38 @@ func (c *Client) RecordSetChangesListAll(zoneID string, filter ListFilterRecordS
 	}
 }
 
+// RecordSetChangesPager returns a Pager over the record set changes in the zone whose ID
+// it's passed, fetching pages lazily starting at filter.StartFrom.
+func (c *Client) RecordSetChangesPager(ctx context.Context, zoneID string, filter ListFilterRecordSetChanges) *Pager[RecordSetChange, int] {
+	return NewPager(ctx, filter.StartFrom, func(ctx context.Context, startFrom int) ([]RecordSetChange, int, error) {
+		if filter.MaxItems > 100 {
+			return nil, 0, fmt.Errorf("MaxItems must be between 1 and 100")
+		}
+		filter.StartFrom = startFrom
+		resp, err := c.RecordSetChangesWithContext(ctx, zoneID, filter)
+		if err != nil {
+			return nil, 0, err
+		}
+		return resp.RecordSetChanges, resp.NextID, nil
+	})
+}
+
+// RecordSetChangesIter returns an iterator over the record set changes in the zone whose ID it's passed.
+func (c *Client) RecordSetChangesIter(ctx context.Context, zoneID string, filter ListFilterRecordSetChanges) iter.Seq2[RecordSetChange, error] {
+	return c.RecordSetChangesPager(ctx, zoneID, filter).All()
+}
+
 // RecordSetChange retrieves the RecordSetChange matching the Zone, RecordSet, and Change IDs
 // it's passed.
 func (c *Client) RecordSetChange(zoneID, recordSetID, changeID string) (*RecordSetChange, error) {
+	return c.RecordSetChangeWithContext(context.Background(), zoneID, recordSetID, changeID)
+}
+
+// RecordSetChangeWithContext retrieves the RecordSetChange matching the Zone, RecordSet, and Change IDs
+// it's passed, using ctx for the lifetime of the request.
+func (c *Client) RecordSetChangeWithContext(ctx context.Context, zoneID, recordSetID, changeID string) (*RecordSetChange, error) {
 	rsc := &RecordSetChange{}
-	err := resourceRequest(c, recordSetChangeEP(c, zoneID, recordSetID, changeID), "GET", nil, rsc)
+	err := resourceRequest(ctx, c, recordSetChangeEP(c, zoneID, recordSetID, changeID), "GET", nil, rsc)
 	if err != nil {
 		return &RecordSetChange{}, err
 	}
@@ -322,8 +535,14 @@ func (c *Client) RecordSetChange(zoneID, recordSetID, changeID string) (*RecordS
 
 // RecordSetChangesFailure retrieves failed record set changes for a zone.
 func (c *Client) RecordSetChangesFailure(zoneID string, filter ListFilter) (*RecordSetChangeFailuresResponse, error) {
+	return c.RecordSetChangesFailureWithContext(context.Background(), zoneID, filter)
+}
+
+// RecordSetChangesFailureWithContext retrieves failed record set changes for a zone,
+// using ctx for the lifetime of the request.
+func (c *Client) RecordSetChangesFailureWithContext(ctx context.Context, zoneID string, filter ListFilter) (*RecordSetChangeFailuresResponse, error) {
 	failures := &RecordSetChangeFailuresResponse{}
-	err := resourceRequest(c, recordSetChangesFailureEP(c, zoneID, filter), "GET", nil, failures)
+	err := resourceRequest(ctx, c, recordSetChangesFailureEP(c, zoneID, filter), "GET", nil, failures)
 	if err != nil {
 		return &RecordSetChangeFailuresResponse{}, err
 	}
@@ -86,7 +86,7 @@ func TestRecordSetChangeHistoryRequiresFields(t *testing.T) {
 func TestRecordSetChangeHistoryError(t *testing.T) {
 	server, client := testTools([]testToolsConfig{
 		{
-			endpoint: "http://host.com/recordsetchange/history?zoneId=z1&fqdn=ok.&recordType=A",
+			endpoint: "http://host.com/recordsetchange/history?fqdn=ok.&recordType=A&zoneId=z1",
 			code:     500,
 			body:     `{"error":"failed"}`,
 		},
@@ -12,11 +12,13 @@ limitations under the License.
 
 package vinyldns
 
-// recordSetsList retrieves the list of record sets with the List criteria passed,
-// for the specified zone.
-func (c *Client) recordSetsList(zoneID string, filter ListFilter) (*RecordSetsResponse, error) {
+import "context"
+
+// recordSetsList retrieves the list of record sets with the ZoneRecordSetFilter
+// criteria passed, for the specified zone.
+func (c *Client) recordSetsList(ctx context.Context, zoneID string, filter ZoneRecordSetFilter) (*RecordSetsResponse, error) {
 	recordSets := &RecordSetsResponse{}
-	err := resourceRequest(c, recordSetsListEP(c, zoneID, filter), "GET", nil, recordSets)
+	err := resourceRequest(ctx, c, recordSetsListEP(c, zoneID, filter), "GET", nil, recordSets)
 	if err != nil {
 		return recordSets, err
 	}
@@ -24,11 +26,20 @@ func (c *Client) recordSetsList(zoneID string, filter ListFilter) (*RecordSetsRe
 	return recordSets, nil
 }
 
+// zoneRecordSetFilter returns the ZoneRecordSetFilter of the ListFilter it's passed.
+func zoneRecordSetFilter(f ListFilter) ZoneRecordSetFilter {
+	return ZoneRecordSetFilter{
+		RecordNameFilter: f.NameFilter,
+		StartFrom:        f.StartFrom,
+		MaxItems:         f.MaxItems,
+	}
+}
+
 // recordSetsGlobalList retrieves the list of record sets with the List criteria passed,
 // across all zones.
-func (c *Client) recordSetsGlobalList(filter GlobalListFilter) (*RecordSetsResponse, error) {
+func (c *Client) recordSetsGlobalList(ctx context.Context, filter GlobalListFilter) (*RecordSetsResponse, error) {
 	recordSets := &RecordSetsResponse{}
-	err := resourceRequest(c, recordSetsGlobalListEP(c, filter), "GET", nil, recordSets)
+	err := resourceRequest(ctx, c, recordSetsGlobalListEP(c, filter), "GET", nil, recordSets)
 	if err != nil {
 		return recordSets, err
 	}
@@ -90,29 +90,65 @@ type RecordSetUpdateResponse struct {
 	Status    string    `json:"status"`
 }
 
-// Record represents a DNS record
+// Record represents a DNS record.
+// Which fields apply depends on the type of the record set the record
+// belongs to; see the New*Record constructors in records.go.
 type Record struct {
-	Address     string `json:"address,omitempty"`
-	CName       string `json:"cname,omitempty"`
-	Preference  int    `json:"preference,omitempty"`
-	Exchange    string `json:"exchange,omitempty"`
-	NSDName     string `json:"nsdname,omitempty"`
-	PTRDName    string `json:"ptrdname,omitempty"`
-	MName       string `json:"mname,omitempty"`
-	RName       string `json:"rname,omitempty"`
-	Serial      int    `json:"serial,omitempty"`
-	Refresh     int    `json:"refresh,omitempty"`
-	Retry       int    `json:"retry,omitempty"`
-	Expire      int    `json:"expire,omitempty"`
-	Minimum     int    `json:"minimum,omitempty"`
-	Text        string `json:"text,omitempty"`
-	Priority    int    `json:"priority,omitempty"`
-	Weight      int    `json:"weight,omitempty"`
-	Port        int    `json:"port,omitempty"`
-	Target      string `json:"target,omitempty"`
-	Algorithm   int    `json:"algorithm,omitempty"`
-	Type        string `json:"type,omitempty"`
-	Fingerprint string `json:"fingerprint,omitempty"`
+	// A and AAAA
+	Address string `json:"address,omitempty"`
+
+	// CNAME
+	CName string `json:"cname,omitempty"`
+
+	// MX and NAPTR
+	Preference int `json:"preference,omitempty"`
+
+	// MX
+	Exchange string `json:"exchange,omitempty"`
+
+	// NS
+	NSDName string `json:"nsdname,omitempty"`
+
+	// PTR
+	PTRDName string `json:"ptrdname,omitempty"`
+
+	// SOA
+	MName   string `json:"mname,omitempty"`
+	RName   string `json:"rname,omitempty"`
+	Serial  int    `json:"serial,omitempty"`
+	Refresh int    `json:"refresh,omitempty"`
+	Retry   int    `json:"retry,omitempty"`
+	Expire  int    `json:"expire,omitempty"`
+	Minimum int    `json:"minimum,omitempty"`
+
+	// SPF and TXT
+	Text string `json:"text,omitempty"`
+
+	// SRV
+	Priority int    `json:"priority,omitempty"`
+	Weight   int    `json:"weight,omitempty"`
+	Port     int    `json:"port,omitempty"`
+	Target   string `json:"target,omitempty"`
+
+	// DS and SSHFP
+	Algorithm int `json:"algorithm,omitempty"`
+
+	// SSHFP. FingerprintType replaces the string Type field of earlier
+	// versions, which couldn't decode the API's numeric fingerprint type.
+	FingerprintType int    `json:"type,omitempty"`
+	Fingerprint     string `json:"fingerprint,omitempty"`
+
+	// DS
+	KeyTag     int    `json:"keytag,omitempty"`
+	DigestType int    `json:"digesttype,omitempty"`
+	Digest     string `json:"digest,omitempty"`
+
+	// NAPTR
+	Order       int    `json:"order,omitempty"`
+	Flags       string `json:"flags,omitempty"`
+	Service     string `json:"service,omitempty"`
+	Regexp      string `json:"regexp,omitempty"`
+	Replacement string `json:"replacement,omitempty"`
 }
 
 // RecordSetResponse represents the JSON
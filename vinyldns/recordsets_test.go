@@ -14,6 +14,7 @@ package vinyldns
 
 import (
 	"encoding/json"
+	"io"
 	"testing"
 
 	"github.com/gobs/pretty"
@@ -199,7 +200,7 @@ func TestRecordSetsGlobal(t *testing.T) {
 			body:     recordSetsListJSON1,
 		},
 		{
-			endpoint: "http://host.com/recordsets?startFrom=2&maxItems=1",
+			endpoint: "http://host.com/recordsets?maxItems=1&startFrom=2",
 			code:     200,
 			body:     recordSetsListJSON2,
 		},
@@ -320,6 +321,54 @@ func TestRecordSetCollector(t *testing.T) {
 	}
 }
 
+func TestRecordSetCollectorPages(t *testing.T) {
+	recordSetsListJSON1, err := readFile("test-fixtures/recordsets/recordsets-list-json-1.json")
+	if err != nil {
+		t.Error(err)
+	}
+	recordSetsListJSON2, err := readFile("test-fixtures/recordsets/recordsets-list-json-2.json")
+	if err != nil {
+		t.Error(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/123/recordsets?maxItems=1",
+			code:     200,
+			body:     recordSetsListJSON1,
+		},
+		{
+			endpoint: "http://host.com/zones/123/recordsets?maxItems=1&startFrom=2",
+			code:     200,
+			body:     recordSetsListJSON2,
+		},
+	})
+
+	defer server.Close()
+
+	collector, err := client.RecordSetCollector("123", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// every page's record sets are returned, with io.EOF only alongside the last
+	for i, want := range []struct {
+		id  string
+		err error
+	}{
+		{"1", nil},
+		{"2", io.EOF},
+	} {
+		rs, err := collector()
+		if err != want.err {
+			t.Errorf("call %d: expected error %v; got %v", i+1, want.err, err)
+		}
+		if len(rs) != 1 || rs[0].ID != want.id {
+			t.Log(pretty.PrettyFormat(rs))
+			t.Errorf("call %d: expected Record Set %s", i+1, want.id)
+		}
+	}
+}
+
 func TestRecordSet(t *testing.T) {
 	recordSetJSON, err := readFile("test-fixtures/recordsets/recordset.json")
 	if err != nil {
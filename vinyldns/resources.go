@@ -25,6 +25,10 @@ type Error struct {
 	RequestBody   string
 	ResponseBody  string
 	ResponseCode  int
+
+	// Message is the human-readable error message
+	// parsed from ResponseBody.
+	Message string
 }
 
 func (d Error) Error() string {
@@ -50,6 +54,25 @@ type ListFilter struct {
 	MaxItems   int
 }
 
+// ZoneListFilter represents the list query parameters that may be passed to
+// VinylDNS API endpoint /zones
+type ZoneListFilter struct {
+	NameFilter string
+	StartFrom  string
+	MaxItems   int
+
+	// SearchByAdminGroup matches NameFilter against the name
+	// of each zone's admin group, rather than the zone's name.
+	SearchByAdminGroup bool
+
+	// IgnoreAccess lists every zone in the system, including
+	// those the user has no access to.
+	IgnoreAccess *bool
+
+	// IncludeReverse includes reverse zones; the API includes them if nil.
+	IncludeReverse *bool
+}
+
 // ListFilterRecordSetChanges represents the list query parameters that may be passed to
 // VinylDNS API endpoint /zones/${zone_id}/recordsetchanges
 type ListFilterRecordSetChanges struct {
@@ -96,3 +119,15 @@ type GlobalListFilter struct {
 	StartFrom              string
 	MaxItems               int
 }
+
+// ZoneRecordSetFilter represents the list query parameters that may be passed to
+// VinylDNS API endpoint /zones/${zone_id}/recordsets. RecordTypeFilter may hold
+// a comma-separated list of record types.
+type ZoneRecordSetFilter struct {
+	RecordNameFilter       string
+	RecordTypeFilter       string
+	RecordOwnerGroupFilter string
+	NameSort               NameSort
+	StartFrom              string
+	MaxItems               int
+}
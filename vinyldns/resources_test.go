@@ -38,11 +38,11 @@ func TestRecordSetMarshaling(t *testing.T) {
 	rs := &RecordSet{
 		Records: []Record{
 			{
-				Type: "text",
+				FingerprintType: 1,
 			},
 		},
 	}
-	expected := "{\"zoneId\":\"\",\"type\":\"\",\"ttl\":0,\"account\":\"\",\"records\":[{\"type\":\"text\"}]}"
+	expected := "{\"zoneId\":\"\",\"type\":\"\",\"ttl\":0,\"account\":\"\",\"records\":[{\"type\":1}]}"
 	r, err := json.Marshal(rs)
 	if err != nil {
 		t.Error(err)
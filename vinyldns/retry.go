@@ -0,0 +1,172 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy configures how the client retries requests that fail
+// with a transient error, such as a connection reset or a 503 returned
+// while VinylDNS is mid blue/green deployment.
+//
+// A nil RetryPolicy, or one whose MaxAttempts is less than 2,
+// disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts made for a request,
+	// including the first one.
+	MaxAttempts int
+
+	// InitialBackoff is the upper bound of the delay before the first retry.
+	// It doubles with each subsequent attempt, up to MaxBackoff.
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts,
+	// including one requested by a Retry-After response header.
+	MaxBackoff time.Duration
+
+	// RetryableStatusCodes lists the HTTP response codes that are retried.
+	// Connection-level errors are always retried.
+	RetryableStatusCodes []int
+
+	// RetryNonIdempotent allows POST requests to be retried. By default,
+	// only GET, HEAD, OPTIONS, PUT and DELETE requests are retried.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy returns a RetryPolicy suitable for riding out
+// brief VinylDNS API restarts.
+func DefaultRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 250 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+	}
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 1
+	}
+
+	return p.MaxAttempts
+}
+
+// shouldRetry reports whether a request that completed its attempt'th try
+// with the status code and transport error passed should be tried again.
+func (p *RetryPolicy) shouldRetry(method string, attempt, code int, transportErr error) bool {
+	if attempt >= p.maxAttempts() {
+		return false
+	}
+
+	if !p.RetryNonIdempotent && !isIdempotent(method) {
+		return false
+	}
+
+	if transportErr != nil {
+		return true
+	}
+
+	for _, c := range p.RetryableStatusCodes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backoff returns how long to wait before the attempt following the
+// attempt'th one. A Retry-After response header takes precedence over
+// the computed exponential backoff, which uses full jitter; both are
+// capped at MaxBackoff, if it's set.
+func (p *RetryPolicy) backoff(attempt int, header http.Header) time.Duration {
+	if d, ok := retryAfter(header, time.Now()); ok {
+		if p.MaxBackoff > 0 && d > p.MaxBackoff {
+			d = p.MaxBackoff
+		}
+		return d
+	}
+
+	if p.InitialBackoff <= 0 {
+		return 0
+	}
+
+	// The ceiling saturates rather than overflowing when MaxBackoff is unset.
+	ceiling := p.InitialBackoff
+	for i := 0; i < min(attempt-1, 62); i++ {
+		if ceiling > math.MaxInt64/2 {
+			ceiling = math.MaxInt64
+			break
+		}
+		ceiling *= 2
+		if p.MaxBackoff > 0 && ceiling >= p.MaxBackoff {
+			break
+		}
+	}
+	if p.MaxBackoff > 0 && ceiling > p.MaxBackoff {
+		ceiling = p.MaxBackoff
+	}
+
+	n := int64(ceiling)
+	if n < math.MaxInt64 {
+		n++
+	}
+
+	return time.Duration(rand.Int63n(n))
+}
+
+// retryAfter parses a Retry-After header, which holds either
+// a number of seconds or an HTTP date.
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	v := header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := t.Sub(now)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+
+	return 0, false
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func flakyServer(t *testing.T, failures int, code int) (*httptest.Server, *int) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Header.Get("Authorization") == "" {
+			t.Error("Expected every attempt to be signed")
+		}
+		if calls <= failures {
+			w.WriteHeader(code)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"zones":[]}`))
+	}))
+
+	return ts, &calls
+}
+
+func testRetryPolicy() *RetryPolicy {
+	p := DefaultRetryPolicy()
+	p.InitialBackoff = time.Millisecond
+	p.MaxBackoff = 2 * time.Millisecond
+
+	return p
+}
+
+func TestSignedRequestRetriesRetryableStatus(t *testing.T) {
+	ts, calls := flakyServer(t, 2, http.StatusServiceUnavailable)
+	defer ts.Close()
+
+	c := NewClient(ClientConfiguration{Host: ts.URL, RetryPolicy: testRetryPolicy()})
+
+	if _, err := c.Zones(); err != nil {
+		t.Error(err)
+	}
+	if *calls != 3 {
+		t.Errorf("Expected 3 attempts; got %d", *calls)
+	}
+}
+
+func TestSignedRequestGivesUpAfterMaxAttempts(t *testing.T) {
+	ts, calls := flakyServer(t, 10, http.StatusBadGateway)
+	defer ts.Close()
+
+	c := NewClient(ClientConfiguration{Host: ts.URL, RetryPolicy: testRetryPolicy()})
+
+	_, err := c.Zones()
+	if vErr, ok := err.(*Error); !ok || vErr.ResponseCode != http.StatusBadGateway {
+		t.Errorf("Expected a 502 Error; got %v", err)
+	}
+	if *calls != 4 {
+		t.Errorf("Expected 4 attempts; got %d", *calls)
+	}
+}
+
+func TestSignedRequestDoesNotRetryNonRetryableStatus(t *testing.T) {
+	ts, calls := flakyServer(t, 1, http.StatusNotFound)
+	defer ts.Close()
+
+	c := NewClient(ClientConfiguration{Host: ts.URL, RetryPolicy: testRetryPolicy()})
+
+	if _, err := c.Zones(); err == nil {
+		t.Error("Expected error for 404 response")
+	}
+	if *calls != 1 {
+		t.Errorf("Expected 1 attempt; got %d", *calls)
+	}
+}
+
+func TestSignedRequestDoesNotRetryPOSTByDefault(t *testing.T) {
+	ts, calls := flakyServer(t, 1, http.StatusServiceUnavailable)
+	defer ts.Close()
+
+	c := NewClient(ClientConfiguration{Host: ts.URL, RetryPolicy: testRetryPolicy()})
+
+	if _, err := c.ZoneCreate(&Zone{Name: "ok."}); err == nil {
+		t.Error("Expected error for non-retried POST")
+	}
+	if *calls != 1 {
+		t.Errorf("Expected 1 attempt; got %d", *calls)
+	}
+
+	policy := testRetryPolicy()
+	policy.RetryNonIdempotent = true
+	c.RetryPolicy = policy
+	*calls = 0
+
+	if _, err := c.ZoneCreate(&Zone{Name: "ok."}); err != nil {
+		t.Error(err)
+	}
+	if *calls != 2 {
+		t.Errorf("Expected 2 attempts; got %d", *calls)
+	}
+}
+
+func TestSignedRequestWithoutRetryPolicy(t *testing.T) {
+	ts, calls := flakyServer(t, 1, http.StatusServiceUnavailable)
+	defer ts.Close()
+
+	c := NewClient(ClientConfiguration{Host: ts.URL})
+
+	if _, err := c.Zones(); err == nil {
+		t.Error("Expected error without a retry policy")
+	}
+	if *calls != 1 {
+		t.Errorf("Expected 1 attempt; got %d", *calls)
+	}
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := &RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: 300 * time.Millisecond}
+
+	for attempt := 1; attempt <= 5; attempt++ {
+		if d := p.backoff(attempt, nil); d < 0 || d > 300*time.Millisecond {
+			t.Errorf("Expected backoff within [0, 300ms] for attempt %d; got %s", attempt, d)
+		}
+	}
+
+	h := http.Header{}
+	h.Set("Retry-After", "2")
+	if d := p.backoff(1, h); d != 300*time.Millisecond {
+		t.Errorf("Expected Retry-After to be capped at MaxBackoff; got %s", d)
+	}
+
+	p.MaxBackoff = 0
+	if d := p.backoff(1, h); d != 2*time.Second {
+		t.Errorf("Expected Retry-After to be honored; got %s", d)
+	}
+}
+
+func TestRetryPolicyBackoffWithoutMaxBackoff(t *testing.T) {
+	p := &RetryPolicy{MaxAttempts: 100, InitialBackoff: 100 * time.Millisecond}
+
+	for attempt := 1; attempt <= p.MaxAttempts; attempt++ {
+		if d := p.backoff(attempt, nil); d < 0 {
+			t.Errorf("Expected a non-negative backoff for attempt %d; got %s", attempt, d)
+		}
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	h := http.Header{}
+	if _, ok := retryAfter(h, now); ok {
+		t.Error("Expected missing Retry-After to be ignored")
+	}
+
+	h.Set("Retry-After", now.Add(30*time.Second).Format(http.TimeFormat))
+	if d, ok := retryAfter(h, now); !ok || d != 30*time.Second {
+		t.Errorf("Expected 30s from HTTP date; got %s", d)
+	}
+
+	h.Set("Retry-After", "soon")
+	if _, ok := retryAfter(h, now); ok {
+		t.Error("Expected malformed Retry-After to be ignored")
+	}
+}
@@ -0,0 +1,318 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Snapshot is the state of a zone at a point in time: the zone, its ACL
+// and all of its record sets. It can be written to and read from JSON,
+// and compared with another snapshot using DiffSnapshots.
+type Snapshot struct {
+	Taken time.Time `json:"taken"`
+
+	// Zone is the zone, without its ACL, which is held in ACL.
+	Zone Zone    `json:"zone"`
+	ACL  ZoneACL `json:"acl"`
+
+	RecordSets []RecordSet `json:"recordSets"`
+}
+
+// ZoneSnapshot returns a Snapshot of the zone whose ID it's passed.
+func (c *Client) ZoneSnapshot(zoneID string) (*Snapshot, error) {
+	return c.ZoneSnapshotWithContext(context.Background(), zoneID)
+}
+
+// ZoneSnapshotWithContext returns a Snapshot of the zone whose ID
+// it's passed, using ctx for the lifetime of the requests.
+func (c *Client) ZoneSnapshotWithContext(ctx context.Context, zoneID string) (*Snapshot, error) {
+	zone, err := c.ZoneWithContext(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	recordSets, err := c.RecordSetsListAllWithContext(ctx, zoneID, ListFilter{})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewSnapshot(zone, recordSets), nil
+}
+
+// ZoneSnapshotDiff returns the differences between the snapshot
+// it's passed and the live state of the snapshot's zone.
+func (c *Client) ZoneSnapshotDiff(before *Snapshot) (*SnapshotDiff, error) {
+	return c.ZoneSnapshotDiffWithContext(context.Background(), before)
+}
+
+// ZoneSnapshotDiffWithContext returns the differences between the snapshot it's
+// passed and the live state of the snapshot's zone, using ctx for the lifetime
+// of the requests.
+func (c *Client) ZoneSnapshotDiffWithContext(ctx context.Context, before *Snapshot) (*SnapshotDiff, error) {
+	after, err := c.ZoneSnapshotWithContext(ctx, before.Zone.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return DiffSnapshots(before, after), nil
+}
+
+// NewSnapshot returns a Snapshot, taken now, of the zone and record sets it's passed.
+func NewSnapshot(zone Zone, recordSets []RecordSet) *Snapshot {
+	s := &Snapshot{
+		Taken:      time.Now().UTC(),
+		Zone:       zone,
+		RecordSets: append([]RecordSet{}, recordSets...),
+	}
+	if zone.ACL != nil {
+		s.ACL = *zone.ACL
+	}
+	s.Zone.ACL = nil
+
+	return s
+}
+
+// ReadSnapshot reads a Snapshot written by Snapshot.Write from r.
+func ReadSnapshot(r io.Reader) (*Snapshot, error) {
+	s := &Snapshot{}
+	if err := json.NewDecoder(r).Decode(s); err != nil {
+		return nil, fmt.Errorf("reading snapshot: %w", err)
+	}
+
+	return s, nil
+}
+
+// Write writes the snapshot to w as indented JSON.
+func (s *Snapshot) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(s)
+}
+
+// SnapshotDiff is the difference between two snapshots of a zone.
+type SnapshotDiff struct {
+	// Added and Removed hold the record sets only in the later
+	// and only in the earlier snapshot respectively.
+	Added   []RecordSet
+	Removed []RecordSet
+
+	// Modified holds the record sets in both snapshots that differ.
+	Modified []RecordSetDiff
+
+	// ACLRulesAdded and ACLRulesRemoved hold the ACL rules only in the
+	// later and only in the earlier snapshot respectively.
+	ACLRulesAdded   []ACLRule
+	ACLRulesRemoved []ACLRule
+}
+
+// RecordSetDiff describes how a record set differs between two snapshots.
+type RecordSetDiff struct {
+	Before RecordSet
+	After  RecordSet
+
+	TTLChanged bool
+
+	// RecordsAdded and RecordsRemoved hold the records only After
+	// and only Before has respectively.
+	RecordsAdded   []Record
+	RecordsRemoved []Record
+
+	OwnerGroupChanged bool
+}
+
+// DiffSnapshots returns the differences between the before and after snapshots of
+// a zone. Record sets are matched by name and type, and records by their values.
+func DiffSnapshots(before, after *Snapshot) *SnapshotDiff {
+	diff := &SnapshotDiff{}
+
+	beforeByKey := snapshotRecordSets(before)
+	afterByKey := snapshotRecordSets(after)
+
+	for key, rs := range afterByKey {
+		if _, ok := beforeByKey[key]; !ok {
+			diff.Added = append(diff.Added, rs)
+		}
+	}
+	for key, b := range beforeByKey {
+		a, ok := afterByKey[key]
+		if !ok {
+			diff.Removed = append(diff.Removed, b)
+			continue
+		}
+		if d := diffRecordSet(b, a); d != nil {
+			diff.Modified = append(diff.Modified, *d)
+		}
+	}
+
+	sortRecordSets(diff.Added)
+	sortRecordSets(diff.Removed)
+	sort.Slice(diff.Modified, func(i, j int) bool {
+		return recordSetLess(diff.Modified[i].After, diff.Modified[j].After)
+	})
+
+	diff.ACLRulesAdded = aclRulesDifference(after.ACL.Rules, before.ACL.Rules)
+	diff.ACLRulesRemoved = aclRulesDifference(before.ACL.Rules, after.ACL.Rules)
+
+	return diff
+}
+
+// Empty reports whether the snapshots compared were the same.
+func (d *SnapshotDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Modified) == 0 &&
+		len(d.ACLRulesAdded) == 0 && len(d.ACLRulesRemoved) == 0
+}
+
+// String renders the diff for review, one line per record set or ACL rule,
+// followed by the fields that differ for each modified record set.
+func (d *SnapshotDiff) String() string {
+	var b strings.Builder
+
+	for _, rs := range d.Added {
+		fmt.Fprintf(&b, "+ %s %s ttl=%d [%s]\n", rs.Name, rs.Type, rs.TTL, strings.Join(formatRecords(rs), ", "))
+	}
+	for _, rs := range d.Removed {
+		fmt.Fprintf(&b, "- %s %s ttl=%d [%s]\n", rs.Name, rs.Type, rs.TTL, strings.Join(formatRecords(rs), ", "))
+	}
+	for _, m := range d.Modified {
+		fmt.Fprintf(&b, "~ %s %s\n", m.After.Name, m.After.Type)
+		if m.TTLChanged {
+			fmt.Fprintf(&b, "    ttl: %d -> %d\n", m.Before.TTL, m.After.TTL)
+		}
+		for _, r := range m.RecordsAdded {
+			fmt.Fprintf(&b, "    + %s\n", formatRecord(RecordType(m.After.Type), r))
+		}
+		for _, r := range m.RecordsRemoved {
+			fmt.Fprintf(&b, "    - %s\n", formatRecord(RecordType(m.Before.Type), r))
+		}
+		if m.OwnerGroupChanged {
+			fmt.Fprintf(&b, "    owner group: %q -> %q\n", m.Before.OwnerGroupID, m.After.OwnerGroupID)
+		}
+	}
+	for _, rule := range d.ACLRulesAdded {
+		fmt.Fprintf(&b, "+ acl %s\n", formatACLRule(rule))
+	}
+	for _, rule := range d.ACLRulesRemoved {
+		fmt.Fprintf(&b, "- acl %s\n", formatACLRule(rule))
+	}
+
+	return b.String()
+}
+
+// snapshotRecordSets returns a snapshot's record sets keyed by name and type.
+func snapshotRecordSets(s *Snapshot) map[string]RecordSet {
+	origin := absoluteName(s.Zone.Name)
+	byKey := map[string]RecordSet{}
+	for _, rs := range s.RecordSets {
+		byKey[strings.ToLower(relativeOwner(rs.Name, origin))+" "+rs.Type] = rs
+	}
+
+	return byKey
+}
+
+// diffRecordSet returns how the record set differs between before
+// and after, or nil if it doesn't.
+func diffRecordSet(before, after RecordSet) *RecordSetDiff {
+	d := &RecordSetDiff{
+		Before:            before,
+		After:             after,
+		TTLChanged:        before.TTL != after.TTL,
+		OwnerGroupChanged: before.OwnerGroupID != after.OwnerGroupID,
+		RecordsAdded:      recordsDifference(after, before),
+		RecordsRemoved:    recordsDifference(before, after),
+	}
+	if !d.TTLChanged && !d.OwnerGroupChanged && len(d.RecordsAdded) == 0 && len(d.RecordsRemoved) == 0 {
+		return nil
+	}
+
+	return d
+}
+
+// recordsDifference returns the records of a that b doesn't have,
+// comparing records by their master file presentation.
+func recordsDifference(a, b RecordSet) []Record {
+	counts := map[string]int{}
+	for _, r := range b.Records {
+		counts[formatRecord(RecordType(b.Type), r)]++
+	}
+
+	diff := []Record{}
+	for _, r := range a.Records {
+		key := formatRecord(RecordType(a.Type), r)
+		if counts[key] > 0 {
+			counts[key]--
+			continue
+		}
+		diff = append(diff, r)
+	}
+	if len(diff) == 0 {
+		return nil
+	}
+
+	return diff
+}
+
+// aclRulesDifference returns the rules of a that b doesn't have.
+func aclRulesDifference(a, b []ACLRule) []ACLRule {
+	keys := map[string]bool{}
+	for _, rule := range b {
+		keys[formatACLRule(rule)] = true
+	}
+
+	var diff []ACLRule
+	for _, rule := range a {
+		if !keys[formatACLRule(rule)] {
+			diff = append(diff, rule)
+		}
+	}
+
+	return diff
+}
+
+func formatRecord(recordType RecordType, r Record) string {
+	rdata, err := FormatRecordData(recordType, r)
+	if err != nil {
+		return fmt.Sprintf("%+v", r)
+	}
+
+	return rdata
+}
+
+func formatACLRule(rule ACLRule) string {
+	types := append([]string{}, rule.RecordTypes...)
+	sort.Strings(types)
+
+	return fmt.Sprintf("%s user=%q group=%q mask=%q types=[%s]", rule.AccessLevel, rule.UserID, rule.GroupID, rule.RecordMask, strings.Join(types, ","))
+}
+
+func sortRecordSets(recordSets []RecordSet) {
+	sort.Slice(recordSets, func(i, j int) bool {
+		return recordSetLess(recordSets[i], recordSets[j])
+	})
+}
+
+func recordSetLess(a, b RecordSet) bool {
+	an, bn := strings.ToLower(a.Name), strings.ToLower(b.Name)
+	if an != bn {
+		return an < bn
+	}
+
+	return a.Type < b.Type
+}
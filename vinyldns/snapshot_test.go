@@ -0,0 +1,141 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiffSnapshots(t *testing.T) {
+	zone := Zone{ID: "123", Name: "ok."}
+	before := NewSnapshot(zone, []RecordSet{
+		NewRecordSet("123", "www", RecordTypeA, 300, NewARecord("10.0.0.1"), NewARecord("10.0.0.2")),
+		NewRecordSet("123", "old", RecordTypeTXT, 300, NewTXTRecord("old")),
+		NewRecordSet("123", "mail", RecordTypeMX, 300, NewMXRecord(10, "mx1.ok.")),
+	})
+	before.ACL.Rules = []ACLRule{{AccessLevel: "Read", GroupID: "g1", RecordTypes: []string{"A"}}}
+
+	www := NewRecordSet("123", "www", RecordTypeA, 600, NewARecord("10.0.0.2"), NewARecord("10.0.0.3"))
+	www.OwnerGroupID = "g2"
+	after := NewSnapshot(zone, []RecordSet{
+		www,
+		NewRecordSet("123", "mail", RecordTypeMX, 300, NewMXRecord(10, "mx1.ok.")),
+		NewRecordSet("123", "new", RecordTypeCNAME, 300, NewCNAMERecord("www.ok.")),
+	})
+	after.ACL.Rules = []ACLRule{{AccessLevel: "Write", GroupID: "g1", RecordTypes: []string{"A"}}}
+
+	diff := DiffSnapshots(before, after)
+
+	if len(diff.Added) != 1 || diff.Added[0].Name != "new" {
+		t.Errorf("Expected new CNAME to be added; got %+v", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].Name != "old" {
+		t.Errorf("Expected old TXT to be removed; got %+v", diff.Removed)
+	}
+	if len(diff.Modified) != 1 {
+		t.Fatalf("Expected www A to be modified; got %+v", diff.Modified)
+	}
+	m := diff.Modified[0]
+	if !m.TTLChanged || !m.OwnerGroupChanged {
+		t.Errorf("Expected TTL and owner group to change; got %+v", m)
+	}
+	if !reflect.DeepEqual(m.RecordsAdded, []Record{NewARecord("10.0.0.3")}) || !reflect.DeepEqual(m.RecordsRemoved, []Record{NewARecord("10.0.0.1")}) {
+		t.Errorf("Expected 10.0.0.3 added and 10.0.0.1 removed; got %+v and %+v", m.RecordsAdded, m.RecordsRemoved)
+	}
+	if len(diff.ACLRulesAdded) != 1 || len(diff.ACLRulesRemoved) != 1 {
+		t.Errorf("Expected the changed ACL rule to be added and removed; got %+v", diff)
+	}
+
+	rendered := diff.String()
+	for _, want := range []string{
+		"+ new CNAME ttl=300 [www.ok.]\n",
+		"- old TXT ttl=300 [\"old\"]\n",
+		"~ www A\n    ttl: 300 -> 600\n    + 10.0.0.3\n    - 10.0.0.1\n    owner group: \"\" -> \"g2\"\n",
+		"+ acl Write",
+		"- acl Read",
+	} {
+		if !strings.Contains(rendered, want) {
+			t.Errorf("Expected the rendered diff to contain %q; got:\n%s", want, rendered)
+		}
+	}
+
+	if !DiffSnapshots(after, after).Empty() {
+		t.Error("Expected a snapshot to have no differences from itself")
+	}
+}
+
+func TestSnapshotJSONRoundTrip(t *testing.T) {
+	s := NewSnapshot(Zone{ID: "123", Name: "ok.", ACL: &ZoneACL{Rules: []ACLRule{{AccessLevel: "Read", RecordTypes: []string{}}}}}, []RecordSet{
+		NewRecordSet("123", "mail", RecordTypeMX, 300, NewMXRecord(0, "mx1.ok.")),
+	})
+
+	var buf bytes.Buffer
+	if err := s.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadSnapshot(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !read.Taken.Equal(s.Taken) || read.Zone.ACL != nil || len(read.ACL.Rules) != 1 {
+		t.Errorf("Unexpected snapshot read back: %+v", read)
+	}
+	if !DiffSnapshots(s, read).Empty() {
+		t.Errorf("Expected no differences after a round trip; got:\n%s", DiffSnapshots(s, read))
+	}
+}
+
+func TestZoneSnapshotDiff(t *testing.T) {
+	zoneJSON, err := readFile("test-fixtures/zones/zone.json")
+	if err != nil {
+		t.Error(err)
+	}
+	recordSetsJSON, err := readFile("test-fixtures/recordsets/recordsets-zone-file.json")
+	if err != nil {
+		t.Error(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/123",
+			code:     200,
+			body:     zoneJSON,
+		},
+		{
+			endpoint: "http://host.com/zones/123/recordsets",
+			code:     200,
+			body:     recordSetsJSON,
+		},
+	})
+	defer server.Close()
+
+	before, err := client.ZoneSnapshot("123")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(before.RecordSets) != 6 || len(before.ACL.Rules) != 1 {
+		t.Fatalf("Expected 6 record sets and 1 ACL rule; got %+v", before)
+	}
+
+	before.RecordSets = before.RecordSets[1:]
+	diff, err := client.ZoneSnapshotDiff(before)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(diff.Added) != 1 || diff.Added[0].Name != "www" || len(diff.Removed) != 0 || len(diff.Modified) != 0 {
+		t.Errorf("Expected only www A to be added since the snapshot; got:\n%s", diff)
+	}
+}
@@ -12,10 +12,18 @@ limitations under the License.
 
 package vinyldns
 
+import "context"
+
 // Status retrieves the current system processing status.
 func (c *Client) Status() (SystemStatus, error) {
+	return c.StatusWithContext(context.Background())
+}
+
+// StatusWithContext retrieves the current system processing status,
+// using ctx for the lifetime of the request.
+func (c *Client) StatusWithContext(ctx context.Context) (SystemStatus, error) {
 	status := &SystemStatus{}
-	err := resourceRequest(c, statusEP(c), "GET", nil, status)
+	err := resourceRequest(ctx, c, statusEP(c), "GET", nil, status)
 	if err != nil {
 		return SystemStatus{}, err
 	}
@@ -25,8 +33,14 @@ func (c *Client) Status() (SystemStatus, error) {
 
 // StatusUpdate updates the system processing status.
 func (c *Client) StatusUpdate(processingDisabled bool) (SystemStatus, error) {
+	return c.StatusUpdateWithContext(context.Background(), processingDisabled)
+}
+
+// StatusUpdateWithContext updates the system processing status,
+// using ctx for the lifetime of the request.
+func (c *Client) StatusUpdateWithContext(ctx context.Context, processingDisabled bool) (SystemStatus, error) {
 	status := &SystemStatus{}
-	err := resourceRequest(c, statusUpdateEP(c, processingDisabled), "POST", nil, status)
+	err := resourceRequest(ctx, c, statusUpdateEP(c, processingDisabled), "POST", nil, status)
 	if err != nil {
 		return SystemStatus{}, err
 	}
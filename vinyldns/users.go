@@ -12,10 +12,18 @@ limitations under the License.
 
 package vinyldns
 
+import "context"
+
 // User retrieves a user by ID or username.
 func (c *Client) User(userIdentifier string) (UserInfo, error) {
+	return c.UserWithContext(context.Background(), userIdentifier)
+}
+
+// UserWithContext retrieves a user by ID or username,
+// using ctx for the lifetime of the request.
+func (c *Client) UserWithContext(ctx context.Context, userIdentifier string) (UserInfo, error) {
 	user := &UserInfo{}
-	err := resourceRequest(c, userEP(c, userIdentifier), "GET", nil, user)
+	err := resourceRequest(ctx, c, userEP(c, userIdentifier), "GET", nil, user)
 	if err != nil {
 		return UserInfo{}, err
 	}
@@ -25,8 +33,14 @@ func (c *Client) User(userIdentifier string) (UserInfo, error) {
 
 // UserLock locks a user account.
 func (c *Client) UserLock(userID string) (UserInfo, error) {
+	return c.UserLockWithContext(context.Background(), userID)
+}
+
+// UserLockWithContext locks a user account,
+// using ctx for the lifetime of the request.
+func (c *Client) UserLockWithContext(ctx context.Context, userID string) (UserInfo, error) {
 	user := &UserInfo{}
-	err := resourceRequest(c, userLockEP(c, userID), "PUT", nil, user)
+	err := resourceRequest(ctx, c, userLockEP(c, userID), "PUT", nil, user)
 	if err != nil {
 		return UserInfo{}, err
 	}
@@ -36,8 +50,14 @@ func (c *Client) UserLock(userID string) (UserInfo, error) {
 
 // UserUnlock unlocks a user account.
 func (c *Client) UserUnlock(userID string) (UserInfo, error) {
+	return c.UserUnlockWithContext(context.Background(), userID)
+}
+
+// UserUnlockWithContext unlocks a user account,
+// using ctx for the lifetime of the request.
+func (c *Client) UserUnlockWithContext(ctx context.Context, userID string) (UserInfo, error) {
 	user := &UserInfo{}
-	err := resourceRequest(c, userUnlockEP(c, userID), "PUT", nil, user)
+	err := resourceRequest(ctx, c, userUnlockEP(c, userID), "PUT", nil, user)
 	if err != nil {
 		return UserInfo{}, err
 	}
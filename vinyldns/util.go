@@ -14,6 +14,7 @@ package vinyldns
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -41,57 +42,106 @@ func concatStrs(delim string, str ...string) string {
 	return strings.Join(str, delim)
 }
 
-func signedRequest(c *Client, url, method string, body []byte) (int, []byte, error) {
-	if logRequests() {
-		fmt.Printf("Request url: \n\t%s\nrequest body: \n\t%s \n\n", url, string(body))
+func signedRequest(ctx context.Context, c *Client, url, method string, body []byte) (int, []byte, error) {
+	for attempt := 1; ; attempt++ {
+		if logRequests() {
+			fmt.Printf("Request url: \n\t%s\nrequest body: \n\t%s \n\n", url, string(body))
+		}
+		req, err := newSignedRequest(ctx, c, url, method, body)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		var statusCode int
+		var header http.Header
+		var bodyContents []byte
+
+		resp, err := c.HTTPClient.Do(req)
+		if err == nil {
+			statusCode, header = resp.StatusCode, resp.Header
+			bodyContents, err = io.ReadAll(resp.Body)
+			resp.Body.Close()
+			if logRequests() {
+				fmt.Printf("Response status: \n\t%d\nresponse body: \n\t%s \n\n", statusCode, bodyContents)
+			}
+		}
+
+		failed := err != nil || !isSuccessCode(statusCode)
+		if failed && ctx.Err() == nil && c.RetryPolicy.shouldRetry(method, attempt, statusCode, err) {
+			if err := sleepContext(ctx, c.RetryPolicy.backoff(attempt, header)); err != nil {
+				return 0, nil, err
+			}
+			continue
+		}
+
+		if err != nil {
+			return statusCode, nil, err
+		}
+		if !isSuccessCode(statusCode) {
+			dError := &Error{}
+			dError.RequestURL = url
+			dError.RequestMethod = method
+			dError.RequestBody = string(body)
+			dError.ResponseCode = statusCode
+			dError.ResponseBody = string(bodyContents)
+			dError.Message = parseErrorMessage(bodyContents)
+			return statusCode, nil, dError
+		}
+
+		return statusCode, bodyContents, nil
 	}
-	req, err := http.NewRequest(method, url, bytes.NewReader(body))
+}
+
+func isSuccessCode(code int) bool {
+	return code == http.StatusOK || code == http.StatusCreated || code == http.StatusAccepted
+}
+
+// newSignedRequest builds a request signed with the client's credentials.
+// A new request is built for each attempt so that every retry carries
+// a fresh signature timestamp and the provider's current credentials.
+func newSignedRequest(ctx context.Context, c *Client, url, method string, body []byte) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(body))
 	if err != nil {
-		return 0, nil, err
+		return nil, err
 	}
 
 	req.Header.Set("User-Agent", c.UserAgent)
 	req.Header.Set("Content-Type", "application/json")
 
+	creds, err := c.credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
 	signer := awsauth.NewSigner()
-	creds := awscred.NewStaticCredentialsProvider(c.AccessKey, c.SecretKey, "")
+	provider := awscred.NewStaticCredentialsProvider(creds.AccessKey, creds.SecretKey, "")
 
 	h := sha256.New()
 	_, _ = io.Copy(h, bytes.NewReader(body))
 	payloadHash := hex.EncodeToString(h.Sum(nil))
-	err = signer.SignHTTP(nil, creds.Value, req, payloadHash, "VinylDNS", "us-east-1", time.Now())
+	err = signer.SignHTTP(ctx, provider.Value, req, payloadHash, "VinylDNS", "us-east-1", time.Now())
 	if err != nil {
-		return 0, nil, err
+		return nil, err
 	}
 
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return 0, nil, err
-	}
-	defer resp.Body.Close()
+	return req, nil
+}
 
-	bodyContents, err := io.ReadAll(resp.Body)
-	if logRequests() {
-		fmt.Printf("Response status: \n\t%d\nresponse body: \n\t%s \n\n", resp.StatusCode, bodyContents)
-	}
-	if err != nil {
-		return resp.StatusCode, nil, err
-	}
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusAccepted {
-		dError := &Error{}
-		dError.RequestURL = url
-		dError.RequestMethod = method
-		dError.RequestBody = string(body)
-		dError.ResponseCode = resp.StatusCode
-		dError.ResponseBody = string(bodyContents)
-		return resp.StatusCode, nil, dError
+// sleepContext waits for d to elapse or for ctx to be done,
+// whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
 	}
-
-	return resp.StatusCode, bodyContents, nil
 }
 
-func resourceRequest(c *Client, url, method string, body []byte, responseStruct interface{}) error {
-	_, bodyContents, err := signedRequest(c, url, method, body)
+func resourceRequest(ctx context.Context, c *Client, url, method string, body []byte, responseStruct interface{}) error {
+	_, bodyContents, err := signedRequest(ctx, c, url, method, body)
 	if err != nil {
 		return err
 	}
@@ -103,8 +153,8 @@ func resourceRequest(c *Client, url, method string, body []byte, responseStruct
 	return nil
 }
 
-func resourceRequestRaw(c *Client, url, method string, body []byte) (int, string, error) {
-	statusCode, bodyContents, err := signedRequest(c, url, method, body)
+func resourceRequestRaw(ctx context.Context, c *Client, url, method string, body []byte) (int, string, error) {
+	statusCode, bodyContents, err := signedRequest(ctx, c, url, method, body)
 	if err != nil {
 		return statusCode, "", err
 	}
@@ -13,6 +13,8 @@ limitations under the License.
 package vinyldns
 
 import (
+	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -52,7 +54,7 @@ func TestResourceRequestWithDefaultUA(t *testing.T) {
 
 	c := NewClient(ClientConfiguration{Host: ts.URL})
 
-	resourceRequest(c, ts.URL, http.MethodGet, nil, nil)
+	resourceRequest(context.Background(), c, ts.URL, http.MethodGet, nil, nil)
 }
 
 func TestResourceRequestWithCustomUA(t *testing.T) {
@@ -69,5 +71,21 @@ func TestResourceRequestWithCustomUA(t *testing.T) {
 		UserAgent: ua,
 	})
 
-	resourceRequest(c, ts.URL, http.MethodGet, nil, nil)
+	resourceRequest(context.Background(), c, ts.URL, http.MethodGet, nil, nil)
+}
+
+func TestResourceRequestWithCanceledContext(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should not reach the server once its context is canceled")
+	}))
+	defer ts.Close()
+
+	c := NewClient(ClientConfiguration{Host: ts.URL})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := c.ZonesWithContext(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled; got: %v", err)
+	}
 }
@@ -0,0 +1,554 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldnstest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+// HoldForReview sets whether new batch changes are held in PendingReview,
+// as VinylDNS does for changes requiring manual review, rather than being
+// applied immediately. Held batch changes are applied when approved. Batch
+// changes submitted with allowManualReview=false are rejected instead.
+func (s *Server) HoldForReview(hold bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.holdForReview = hold
+}
+
+// EnableScheduledChanges sets whether batch changes may be scheduled, which,
+// as in VinylDNS, they may not by default. Scheduled batch changes are held
+// in the Scheduled status, and applied when approved after their scheduled time.
+func (s *Server) EnableScheduledChanges(enabled bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.scheduledChanges = enabled
+}
+
+// SetBatchChangeLimit sets the maximum number of single changes in a batch
+// change, vinyldns.DefaultBatchChangeLimit by default. Larger batch changes
+// are rejected with a 413, as VinylDNS does.
+func (s *Server) SetBatchChangeLimit(limit int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.batchChangeLimit = limit
+}
+
+// batchChangeInputError is a single change of a rejected batch change,
+// along with the reasons it is invalid.
+type batchChangeInputError struct {
+	vinyldns.RecordChange
+	Errors []string `json:"errors,omitempty"`
+}
+
+// MarshalJSON encodes the change, as encoded by RecordChange.MarshalJSON,
+// along with its errors, which the promoted method would otherwise omit.
+func (e batchChangeInputError) MarshalJSON() ([]byte, error) {
+	b, err := json.Marshal(e.RecordChange)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]json.RawMessage{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	if len(e.Errors) != 0 {
+		if fields["errors"], err = json.Marshal(e.Errors); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+func (s *Server) routeBatchChanges(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listBatchChanges(w, r)
+		case http.MethodPost:
+			s.createBatchChange(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	change := s.findBatchChange(segs[0])
+	if change == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Batch change with id %s cannot be found", segs[0]))
+		return
+	}
+
+	switch {
+	case len(segs) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, change)
+	case len(segs) == 2 && r.Method == http.MethodPost:
+		s.reviewBatchChange(w, r, change, segs[1])
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) findBatchChange(id string) *vinyldns.BatchRecordChange {
+	for _, change := range s.batchChanges {
+		if change.ID == id {
+			return change
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) listBatchChanges(w http.ResponseWriter, r *http.Request) {
+	p, err := parsePage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	q := r.URL.Query()
+	approvalStatus, userName, groupName := q.Get("approvalStatus"), q.Get("userName"), q.Get("groupName")
+	var rangeStart, rangeEnd time.Time
+	for _, bound := range []struct {
+		key string
+		t   *time.Time
+	}{{"dateTimeRangeStart", &rangeStart}, {"dateTimeRangeEnd", &rangeEnd}} {
+		if v := q.Get(bound.key); v != "" {
+			if *bound.t, err = time.Parse(time.RFC3339, v); err != nil {
+				writeError(w, http.StatusBadRequest, fmt.Sprintf("%s %q is invalid", bound.key, v))
+				return
+			}
+		}
+	}
+
+	// Every batch change is the fake's single user's, so ignoreAccess has no effect.
+	summaries := []vinyldns.BatchChangeSummary{}
+	for _, change := range s.batchChanges {
+		summary := vinyldns.BatchChangeSummary{
+			ID:                 change.ID,
+			UserID:             change.UserID,
+			UserName:           change.UserName,
+			Comments:           change.Comments,
+			CreatedTimestamp:   change.CreatedTimestamp,
+			TotalChanges:       len(change.Changes),
+			Status:             change.Status,
+			OwnerGroupID:       change.OwnerGroupID,
+			ApprovalStatus:     change.ApprovalStatus,
+			ReviewerID:         change.ReviewerID,
+			ReviewerName:       change.ReviewerUserName,
+			ReviewComment:      change.ReviewComment,
+			ReviewTimestamp:    change.ReviewTimestamp,
+			ScheduledTime:      change.ScheduledTime,
+			CancelledTimestamp: change.CancelledTimestamp,
+		}
+		if g, ok := s.groups[change.OwnerGroupID]; ok {
+			summary.OwnerGroupName = g.Name
+		}
+
+		created, _ := time.Parse(time.RFC3339, change.CreatedTimestamp)
+		switch {
+		case approvalStatus != "" && summary.ApprovalStatus != approvalStatus,
+			userName != "" && summary.UserName != userName,
+			groupName != "" && summary.OwnerGroupName != groupName,
+			!rangeStart.IsZero() && created.Before(rangeStart),
+			!rangeEnd.IsZero() && created.After(rangeEnd):
+			continue
+		}
+		summaries = append(summaries, summary)
+	}
+
+	lo, hi, next := p.bounds(len(summaries))
+	writeJSON(w, http.StatusOK, vinyldns.BatchChangeSummaries{
+		BatchChanges:   summaries[lo:hi],
+		StartFrom:      p.start,
+		NextID:         next,
+		MaxItems:       p.maxItems,
+		IgnoreAccess:   q.Get("ignoreAccess") == "true",
+		ApprovalStatus: approvalStatus,
+	})
+}
+
+func (s *Server) createBatchChange(w http.ResponseWriter, r *http.Request) {
+	batch := vinyldns.BatchRecordChange{}
+	if !decode(w, r, &batch) {
+		return
+	}
+	if len(batch.Changes) == 0 {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Batch change contained no changes. Batch change must have at least one change, up to a maximum of %d changes.", s.batchChangeLimit))
+		return
+	}
+	if len(batch.Changes) > s.batchChangeLimit {
+		writeError(w, http.StatusRequestEntityTooLarge, fmt.Sprintf("Cannot request more than %d changes in a single batch change request", s.batchChangeLimit))
+		return
+	}
+	if batch.ScheduledTime != "" {
+		if !s.scheduledChanges {
+			writeError(w, http.StatusBadRequest, "Cannot create a scheduled change, as it is currently disabled on this VinylDNS instance.")
+			return
+		}
+		scheduled, err := time.Parse(time.RFC3339, batch.ScheduledTime)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid scheduledTime %q", batch.ScheduledTime))
+			return
+		}
+		if !scheduled.After(time.Now()) {
+			writeError(w, http.StatusBadRequest, "Scheduled time must be in the future.")
+			return
+		}
+	}
+	if s.holdForReview && r.URL.Query().Get("allowManualReview") == "false" {
+		writeError(w, http.StatusBadRequest, "Batch change requires manual review, which allowManualReview=false does not allow.")
+		return
+	}
+
+	inputs := make([]batchChangeInputError, len(batch.Changes))
+	invalid := false
+	for i, rc := range batch.Changes {
+		inputs[i] = batchChangeInputError{RecordChange: rc, Errors: s.validateRecordChange(&batch.Changes[i])}
+		invalid = invalid || len(inputs[i].Errors) != 0
+	}
+	if invalid {
+		writeJSON(w, http.StatusBadRequest, inputs)
+		return
+	}
+
+	batch.ID = newID()
+	batch.UserID = DefaultUserID
+	batch.UserName = DefaultUserID
+	batch.CreatedTimestamp = now()
+	batch.ApprovalStatus = vinyldns.ApprovalStatusAutoApproved
+	for i := range batch.Changes {
+		batch.Changes[i].ID = newID()
+		batch.Changes[i].Status = "Pending"
+	}
+
+	switch {
+	case batch.ScheduledTime != "":
+		batch.Status = vinyldns.BatchChangeStatusScheduled
+	case s.holdForReview:
+		batch.Status = vinyldns.BatchChangeStatusPendingReview
+	default:
+		batch.Status = vinyldns.BatchChangeStatusPendingProcessing
+	}
+	if batch.Status != vinyldns.BatchChangeStatusPendingProcessing {
+		batch.ApprovalStatus = vinyldns.ApprovalStatusPendingReview
+		for i := range batch.Changes {
+			batch.Changes[i].Status = "NeedsReview"
+		}
+	}
+	stored := batch
+	stored.Changes = append([]vinyldns.RecordChange{}, batch.Changes...)
+	s.batchChanges = append([]*vinyldns.BatchRecordChange{&stored}, s.batchChanges...)
+
+	if stored.Status == vinyldns.BatchChangeStatusPendingProcessing {
+		s.applyBatchChange(&stored)
+	}
+
+	writeJSON(w, http.StatusAccepted, vinyldns.BatchRecordChangeUpdateResponse{
+		ID:               batch.ID,
+		UserName:         batch.UserName,
+		UserID:           batch.UserID,
+		Status:           batch.Status,
+		Comments:         batch.Comments,
+		CreatedTimestamp: batch.CreatedTimestamp,
+		OwnerGroupID:     batch.OwnerGroupID,
+		Changes:          batch.Changes,
+		ApprovalStatus:   batch.ApprovalStatus,
+		ScheduledTime:    batch.ScheduledTime,
+	})
+}
+
+// validateRecordChange resolves the zone and record name of rc,
+// returning the reasons it cannot be applied, if any.
+func (s *Server) validateRecordChange(rc *vinyldns.RecordChange) []string {
+	errs := []string{}
+
+	if rc.ChangeType != vinyldns.ChangeTypeAdd && rc.ChangeType != vinyldns.ChangeTypeDeleteRecordSet {
+		return append(errs, fmt.Sprintf("Invalid change type %q", rc.ChangeType))
+	}
+
+	name := ensureDot(rc.InputName)
+	if rc.Type == "PTR" {
+		if ip := net.ParseIP(rc.InputName); ip != nil {
+			name = reverseName(ip)
+		}
+	}
+
+	zone := s.zoneForFQDN(name)
+	if zone == nil {
+		return append(errs, fmt.Sprintf("Zone Discovery Failed: zone for \"%s\" does not exist in VinylDNS. If zone exists, then it must be connected to in VinylDNS.", rc.InputName))
+	}
+	rc.ZoneID = zone.ID
+	rc.ZoneName = zone.Name
+	rc.RecordName = relativeName(name, zone.Name)
+
+	existing := s.findRecordSet(zone.ID, rc.RecordName, rc.Type)
+
+	if rc.ChangeType == vinyldns.ChangeTypeDeleteRecordSet {
+		switch {
+		case existing == nil:
+			errs = append(errs, fmt.Sprintf("Record \"%s\" Does Not Exist: cannot delete a record that does not exist.", rc.InputName))
+		case rc.Record != vinyldns.RecordData{}:
+			record, err := batchRecord(rc.Type, rc.Record)
+			if err != nil {
+				errs = append(errs, err.Error())
+			} else if !slices.Contains(existing.Records, record) {
+				errs = append(errs, fmt.Sprintf("Record data %+v does not exist for \"%s\".", rc.Record, rc.InputName))
+			}
+		}
+		return errs
+	}
+
+	if _, err := batchRecord(rc.Type, rc.Record); err != nil {
+		errs = append(errs, err.Error())
+	}
+	for _, other := range s.recordSets {
+		if other.ZoneID != zone.ID || !strings.EqualFold(other.Name, rc.RecordName) || other.Type == rc.Type {
+			continue
+		}
+		if other.Type == "CNAME" || rc.Type == "CNAME" {
+			errs = append(errs, fmt.Sprintf("CNAME Conflict: CNAME record names must be unique. Existing record with name \"%s\" and type \"%s\" conflicts with this record.", name, other.Type))
+		}
+	}
+	if existing != nil && rc.Type == "CNAME" {
+		errs = append(errs, fmt.Sprintf("Record \"%s\" Already Exists: cannot add an existing record; to update it, issue a DeleteRecordSet then an Add.", rc.InputName))
+	}
+
+	return errs
+}
+
+// batchRecord converts the record data of a batch change to a Record.
+func batchRecord(recordType string, data vinyldns.RecordData) (vinyldns.Record, error) {
+	r := data.Record()
+
+	switch recordType {
+	case "A", "AAAA":
+		if net.ParseIP(r.Address) == nil {
+			return vinyldns.Record{}, fmt.Errorf("Invalid IP address: \"%s\".", r.Address)
+		}
+		return vinyldns.NewARecord(r.Address), nil
+	case "CNAME":
+		if r.CName == "" {
+			return vinyldns.Record{}, fmt.Errorf("Missing CNAME.cname")
+		}
+		return vinyldns.NewCNAMERecord(ensureDot(r.CName)), nil
+	case "MX":
+		if r.Exchange == "" {
+			return vinyldns.Record{}, fmt.Errorf("Missing MX.exchange")
+		}
+		return vinyldns.NewMXRecord(r.Preference, ensureDot(r.Exchange)), nil
+	case "NAPTR":
+		if r.Replacement == "" {
+			return vinyldns.Record{}, fmt.Errorf("Missing NAPTR.replacement")
+		}
+		return vinyldns.NewNAPTRRecord(r.Order, r.Preference, r.Flags, r.Service, r.Regexp, ensureDot(r.Replacement)), nil
+	case "NS":
+		if r.NSDName == "" {
+			return vinyldns.Record{}, fmt.Errorf("Missing NS.nsdname")
+		}
+		return vinyldns.NewNSRecord(ensureDot(r.NSDName)), nil
+	case "PTR":
+		if r.PTRDName == "" {
+			return vinyldns.Record{}, fmt.Errorf("Missing PTR.ptrdname")
+		}
+		return vinyldns.NewPTRRecord(ensureDot(r.PTRDName)), nil
+	case "SRV":
+		if r.Target == "" {
+			return vinyldns.Record{}, fmt.Errorf("Missing SRV.target")
+		}
+		return vinyldns.NewSRVRecord(r.Priority, r.Weight, r.Port, ensureDot(r.Target)), nil
+	case "SSHFP":
+		if r.Fingerprint == "" {
+			return vinyldns.Record{}, fmt.Errorf("Missing SSHFP.fingerprint")
+		}
+		return vinyldns.NewSSHFPRecord(r.Algorithm, r.FingerprintType, r.Fingerprint), nil
+	case "TXT":
+		if r.Text == "" {
+			return vinyldns.Record{}, fmt.Errorf("Missing TXT.text")
+		}
+		return vinyldns.NewTXTRecord(r.Text), nil
+	}
+
+	return vinyldns.Record{}, fmt.Errorf("Unsupported type %s, valid types include: A, AAAA, CNAME, MX, NAPTR, NS, PTR, SRV, SSHFP and TXT", recordType)
+}
+
+// reverseName returns the in-addr.arpa or ip6.arpa name of ip.
+func reverseName(ip net.IP) string {
+	if v4 := ip.To4(); v4 != nil {
+		return fmt.Sprintf("%d.%d.%d.%d.in-addr.arpa.", v4[3], v4[2], v4[1], v4[0])
+	}
+
+	nibbles := make([]string, 0, 32)
+	for i := len(ip) - 1; i >= 0; i-- {
+		nibbles = append(nibbles, fmt.Sprintf("%x.%x", ip[i]&0x0f, ip[i]>>4))
+	}
+
+	return strings.Join(nibbles, ".") + ".ip6.arpa."
+}
+
+func (s *Server) findRecordSet(zoneID, name, recordType string) *vinyldns.RecordSet {
+	for _, rs := range s.recordSets {
+		if rs.ZoneID == zoneID && rs.Type == recordType && strings.EqualFold(rs.Name, name) {
+			return rs
+		}
+	}
+
+	return nil
+}
+
+// applyBatchChange applies each single change of a validated
+// batch change to the server's record sets.
+func (s *Server) applyBatchChange(batch *vinyldns.BatchRecordChange) {
+	for i := range batch.Changes {
+		rc := &batch.Changes[i]
+		zone := s.zones[rc.ZoneID]
+		existing := s.findRecordSet(rc.ZoneID, rc.RecordName, rc.Type)
+
+		var change vinyldns.RecordSetChange
+		switch {
+		case rc.ChangeType == vinyldns.ChangeTypeDeleteRecordSet && existing != nil && rc.Record != vinyldns.RecordData{}:
+			record, _ := batchRecord(rc.Type, rc.Record)
+			old := *existing
+			updated := *existing
+			updated.Records = slices.DeleteFunc(append([]vinyldns.Record{}, existing.Records...), func(r vinyldns.Record) bool {
+				return r == record
+			})
+			if len(updated.Records) == 0 {
+				delete(s.recordSets, existing.ID)
+				change = s.recordRecordSetChange(zone, old, vinyldns.RecordSet{}, "Delete")
+				break
+			}
+			updated.Updated = now()
+			s.putRecordSet(&updated)
+			change = s.recordRecordSetChange(zone, updated, old, "Update")
+		case rc.ChangeType == vinyldns.ChangeTypeDeleteRecordSet && existing != nil:
+			delete(s.recordSets, existing.ID)
+			change = s.recordRecordSetChange(zone, *existing, vinyldns.RecordSet{}, "Delete")
+		case rc.ChangeType == vinyldns.ChangeTypeAdd && existing != nil:
+			record, _ := batchRecord(rc.Type, rc.Record)
+			old := *existing
+			updated := *existing
+			updated.Records = append(append([]vinyldns.Record{}, existing.Records...), record)
+			updated.Updated = now()
+			s.putRecordSet(&updated)
+			change = s.recordRecordSetChange(zone, updated, old, "Update")
+		case rc.ChangeType == vinyldns.ChangeTypeAdd:
+			record, _ := batchRecord(rc.Type, rc.Record)
+			ttl := rc.TTL
+			if ttl == 0 {
+				ttl = 7200
+			}
+			rs := vinyldns.RecordSet{
+				ZoneID:       rc.ZoneID,
+				Name:         rc.RecordName,
+				Type:         rc.Type,
+				TTL:          ttl,
+				OwnerGroupID: batch.OwnerGroupID,
+				Records:      []vinyldns.Record{record},
+			}
+			s.putRecordSet(&rs)
+			change = s.recordRecordSetChange(zone, rs, vinyldns.RecordSet{}, "Create")
+		default:
+			rc.Status = "Failed"
+			rc.SystemMessage = "Record set no longer exists"
+			continue
+		}
+
+		s.recordSetChanges[zone.ID][0].SingleBatchChangeIDs = []string{rc.ID}
+		rc.Status = "Complete"
+		rc.RecordChangeID = change.ID
+		rc.RecordSetID = change.RecordSet.ID
+	}
+
+	batch.Status = batchStatus(batch.Changes)
+}
+
+func batchStatus(changes []vinyldns.RecordChange) string {
+	failed := 0
+	for _, rc := range changes {
+		if rc.Status == "Failed" {
+			failed++
+		}
+	}
+
+	switch failed {
+	case 0:
+		return "Complete"
+	case len(changes):
+		return "Failed"
+	}
+
+	return "PartialFailure"
+}
+
+func (s *Server) reviewBatchChange(w http.ResponseWriter, r *http.Request, batch *vinyldns.BatchRecordChange, action string) {
+	review := vinyldns.BatchChangeReview{}
+	if r.ContentLength != 0 && !decode(w, r, &review) {
+		return
+	}
+	if batch.Status != vinyldns.BatchChangeStatusPendingReview && batch.Status != vinyldns.BatchChangeStatusScheduled {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Batch change %s is not pending review.", batch.ID))
+		return
+	}
+	if scheduled, ok := batch.ScheduledAt(); ok && action == "approve" && scheduled.After(time.Now()) {
+		writeError(w, http.StatusForbidden, fmt.Sprintf("Cannot process scheduled change as it is not past the scheduled date of %s", batch.ScheduledTime))
+		return
+	}
+
+	timestamp := now()
+	switch action {
+	case "approve":
+		batch.ApprovalStatus = vinyldns.ApprovalStatusManuallyApproved
+		batch.ReviewerID = DefaultUserID
+		batch.ReviewerUserName = DefaultUserID
+		batch.ReviewComment = review.ReviewComment
+		batch.ReviewTimestamp = timestamp
+		s.applyBatchChange(batch)
+	case "reject":
+		batch.Status = "Rejected"
+		batch.ApprovalStatus = vinyldns.ApprovalStatusManuallyRejected
+		batch.ReviewerID = DefaultUserID
+		batch.ReviewerUserName = DefaultUserID
+		batch.ReviewComment = review.ReviewComment
+		batch.ReviewTimestamp = timestamp
+		setChangeStatus(batch, "Rejected")
+	case "cancel":
+		batch.Status = "Cancelled"
+		batch.ApprovalStatus = vinyldns.ApprovalStatusCancelled
+		batch.CancelledTimestamp = timestamp
+		setChangeStatus(batch, "Cancelled")
+	default:
+		notFound(w, r)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, batch)
+}
+
+func setChangeStatus(batch *vinyldns.BatchRecordChange, status string) {
+	for i := range batch.Changes {
+		batch.Changes[i].Status = status
+	}
+}
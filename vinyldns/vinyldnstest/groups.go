@@ -0,0 +1,249 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldnstest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+// AddGroup adds a group directly to the server's state.
+// If the group has no admins, DefaultUserID is made its admin and member.
+func (s *Server) AddGroup(g vinyldns.Group) vinyldns.Group {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g.ID = ""
+	s.putGroup(&g)
+	s.recordGroupChange(g, vinyldns.Group{}, "Create")
+
+	return g
+}
+
+func (s *Server) putGroup(g *vinyldns.Group) {
+	if g.ID == "" {
+		g.ID = newID()
+		g.Created = now()
+	}
+	g.Status = "Active"
+	if len(g.Admins) == 0 {
+		g.Admins = []vinyldns.User{{ID: DefaultUserID}}
+	}
+	g.Admins = s.expandUsers(g.Admins)
+	g.Members = s.expandUsers(append(g.Members, g.Admins...))
+
+	stored := *g
+	s.groups[g.ID] = &stored
+}
+
+// expandUsers de-duplicates users by ID and fills in
+// the user names of those known to the server.
+func (s *Server) expandUsers(users []vinyldns.User) []vinyldns.User {
+	seen := map[string]bool{}
+	expanded := []vinyldns.User{}
+	for _, u := range users {
+		if seen[u.ID] {
+			continue
+		}
+		seen[u.ID] = true
+		if known, ok := s.users[u.ID]; ok {
+			u.UserName = known.UserName
+		}
+		expanded = append(expanded, u)
+	}
+
+	return expanded
+}
+
+func (s *Server) recordGroupChange(newGroup, oldGroup vinyldns.Group, changeType string) vinyldns.GroupChange {
+	change := vinyldns.GroupChange{
+		ID:                 newID(),
+		UserID:             DefaultUserID,
+		UserName:           DefaultUserID,
+		Created:            now(),
+		ChangeType:         changeType,
+		GroupChangeMessage: fmt.Sprintf("Group %s.", strings.ToLower(changeType)+"d"),
+		NewGroup:           newGroup,
+		OldGroup:           oldGroup,
+	}
+	s.groupChanges = append([]vinyldns.GroupChange{change}, s.groupChanges...)
+
+	return change
+}
+
+func (s *Server) routeGroups(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listGroups(w, r)
+		case http.MethodPost:
+			s.createGroup(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	switch {
+	case len(segs) == 2 && segs[0] == "change":
+		for _, change := range s.groupChanges {
+			if change.ID == segs[1] {
+				writeJSON(w, http.StatusOK, change)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Group change with id %s does not exist", segs[1]))
+		return
+	case len(segs) == 2 && segs[0] == "valid" && segs[1] == "domains":
+		writeJSON(w, http.StatusOK, []string{"*"})
+		return
+	}
+
+	group, ok := s.groups[segs[0]]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Group with ID %s was not found", segs[0]))
+		return
+	}
+
+	if len(segs) == 2 && r.Method == http.MethodGet {
+		switch segs[1] {
+		case "admins":
+			writeJSON(w, http.StatusOK, vinyldns.GroupAdmins{GroupAdmins: group.Admins})
+		case "members":
+			writeJSON(w, http.StatusOK, vinyldns.GroupMembers{GroupMembers: group.Members})
+		case "activity":
+			changes := []vinyldns.GroupChange{}
+			for _, change := range s.groupChanges {
+				if change.NewGroup.ID == group.ID {
+					changes = append(changes, change)
+				}
+			}
+			writeJSON(w, http.StatusOK, vinyldns.GroupChanges{Changes: changes})
+		default:
+			notFound(w, r)
+		}
+		return
+	}
+	if len(segs) != 1 {
+		notFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, group)
+	case http.MethodPut:
+		s.updateGroup(w, r, group)
+	case http.MethodDelete:
+		s.deleteGroup(w, group)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) listGroups(w http.ResponseWriter, r *http.Request) {
+	p, err := parsePage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	nameFilter := r.URL.Query().Get("groupNameFilter")
+
+	groups := []vinyldns.Group{}
+	for _, g := range s.groups {
+		if nameFilter == "" || containsFold(g.Name, nameFilter) {
+			groups = append(groups, *g)
+		}
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].Name < groups[j].Name })
+
+	lo, hi, next := p.bounds(len(groups))
+	writeJSON(w, http.StatusOK, vinyldns.Groups{
+		Groups:          groups[lo:hi],
+		GroupNameFilter: nameFilter,
+		StartFrom:       cursor(p.start),
+		MaxItems:        p.maxItems,
+		NextID:          cursor(next),
+	})
+}
+
+func (s *Server) createGroup(w http.ResponseWriter, r *http.Request) {
+	g := vinyldns.Group{}
+	if !decode(w, r, &g) {
+		return
+	}
+	if g.Name == "" || g.Email == "" {
+		writeError(w, http.StatusBadRequest, "Group name and email are required")
+		return
+	}
+	if s.findGroupByName(g.Name) != nil {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Group with name %s already exists.", g.Name))
+		return
+	}
+
+	g.ID = ""
+	s.putGroup(&g)
+	s.recordGroupChange(g, vinyldns.Group{}, "Create")
+	writeJSON(w, http.StatusOK, g)
+}
+
+func (s *Server) updateGroup(w http.ResponseWriter, r *http.Request, existing *vinyldns.Group) {
+	g := vinyldns.Group{}
+	if !decode(w, r, &g) {
+		return
+	}
+	if g.Name == "" || g.Email == "" {
+		writeError(w, http.StatusBadRequest, "Group name and email are required")
+		return
+	}
+	if other := s.findGroupByName(g.Name); other != nil && other.ID != existing.ID {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Group with name %s already exists.", g.Name))
+		return
+	}
+
+	old := *existing
+	g.ID = existing.ID
+	g.Created = existing.Created
+	s.putGroup(&g)
+	s.recordGroupChange(g, old, "Update")
+	writeJSON(w, http.StatusOK, g)
+}
+
+func (s *Server) deleteGroup(w http.ResponseWriter, group *vinyldns.Group) {
+	for _, z := range s.zones {
+		if z.AdminGroupID == group.ID {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("%s is the admin of a zone. Cannot delete. Please transfer the ownership to another group before deleting.", group.Name))
+			return
+		}
+	}
+
+	delete(s.groups, group.ID)
+	deleted := *group
+	deleted.Status = "Deleted"
+	s.recordGroupChange(deleted, *group, "Delete")
+	writeJSON(w, http.StatusOK, deleted)
+}
+
+func (s *Server) findGroupByName(name string) *vinyldns.Group {
+	for _, g := range s.groups {
+		if strings.EqualFold(g.Name, name) {
+			return g
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldnstest
+
+//go:generate go run mockgen.go
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"sync"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+// ErrNotMocked is returned by Mock methods whose Func field is not set.
+var ErrNotMocked = errors.New("vinyldnstest: method not mocked")
+
+// Call is a single recorded call to a Mock method.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+// callRecorder records the calls made to a Mock.
+type callRecorder struct {
+	mu    sync.Mutex
+	calls []Call
+}
+
+// Calls returns the calls made so far, in order.
+func (r *callRecorder) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Call{}, r.calls...)
+}
+
+// CallsTo returns the calls made so far to the method whose name it's passed.
+func (r *callRecorder) CallsTo(method string) []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	calls := []Call{}
+	for _, c := range r.calls {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+
+	return calls
+}
+
+// Reset forgets the calls made so far.
+func (r *callRecorder) Reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = nil
+}
+
+func (r *callRecorder) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.calls = append(r.calls, Call{Method: method, Args: args})
+}
+
+func notMocked(method string) error {
+	return fmt.Errorf("%w: %s", ErrNotMocked, method)
+}
+
+func notMockedSeq[T any](method string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		yield(zero, notMocked(method))
+	}
+}
+
+func notMockedPager[T any, C comparable](method string) *vinyldns.Pager[T, C] {
+	var start C
+	return vinyldns.NewPager(context.Background(), start, func(context.Context, C) ([]T, C, error) {
+		var next C
+		return nil, next, notMocked(method)
+	})
+}
@@ -0,0 +1,2344 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by mockgen.go; DO NOT EDIT.
+
+package vinyldnstest
+
+import (
+	"context"
+	"io"
+	"iter"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+var _ vinyldns.API = (*Mock)(nil)
+
+// Mock is a vinyldns.API whose methods record each call and then
+// delegate to the corresponding Func field. A method whose Func field is
+// not set falls back to the Func field of its WithContext variant, if any,
+// and otherwise returns zero values and an error wrapping ErrNotMocked.
+type Mock struct {
+	callRecorder
+
+	ZonesFunc                           func() ([]vinyldns.Zone, error)
+	ZonesWithContextFunc                func(ctx context.Context) ([]vinyldns.Zone, error)
+	ZonesListAllFunc                    func(filter vinyldns.ListFilter) ([]vinyldns.Zone, error)
+	ZonesListAllWithContextFunc         func(ctx context.Context, filter vinyldns.ListFilter) ([]vinyldns.Zone, error)
+	ZonesListFunc                       func(filter vinyldns.ZoneListFilter) (*vinyldns.Zones, error)
+	ZonesListWithContextFunc            func(ctx context.Context, filter vinyldns.ZoneListFilter) (*vinyldns.Zones, error)
+	ZonesListAllFilteredFunc            func(filter vinyldns.ZoneListFilter) ([]vinyldns.Zone, error)
+	ZonesListAllFilteredWithContextFunc func(ctx context.Context, filter vinyldns.ZoneListFilter) ([]vinyldns.Zone, error)
+	ZonesPagerFunc                      func(ctx context.Context, filter vinyldns.ListFilter) *vinyldns.Pager[vinyldns.Zone, string]
+	ZonesIterFunc                       func(ctx context.Context, filter vinyldns.ListFilter) iter.Seq2[vinyldns.Zone, error]
+	ZonesPagerFilteredFunc              func(ctx context.Context, filter vinyldns.ZoneListFilter) *vinyldns.Pager[vinyldns.Zone, string]
+	ZonesIterFilteredFunc               func(ctx context.Context, filter vinyldns.ZoneListFilter) iter.Seq2[vinyldns.Zone, error]
+	ZoneFunc                            func(id string) (vinyldns.Zone, error)
+	ZoneWithContextFunc                 func(ctx context.Context, id string) (vinyldns.Zone, error)
+	ZoneDetailsFunc                     func(id string) (vinyldns.ZoneDetails, error)
+	ZoneDetailsWithContextFunc          func(ctx context.Context, id string) (vinyldns.ZoneDetails, error)
+	ZoneBackendIDsFunc                  func() ([]string, error)
+	ZoneBackendIDsWithContextFunc       func(ctx context.Context) ([]string, error)
+	ZoneByIDFunc                        func(id string) (vinyldns.Zone, error)
+	ZoneByIDWithContextFunc             func(ctx context.Context, id string) (vinyldns.Zone, error)
+	ZoneByNameFunc                      func(name string) (vinyldns.Zone, error)
+	ZoneByNameWithContextFunc           func(ctx context.Context, name string) (vinyldns.Zone, error)
+	ZonesDeletedFunc                    func(filter vinyldns.DeletedZonesFilter) (*vinyldns.DeletedZonesResponse, error)
+	ZonesDeletedWithContextFunc         func(ctx context.Context, filter vinyldns.DeletedZonesFilter) (*vinyldns.DeletedZonesResponse, error)
+	ZoneACLRuleCreateFunc               func(zoneID string, rule *vinyldns.ACLRule) (*vinyldns.ZoneUpdateResponse, error)
+	ZoneACLRuleCreateWithContextFunc    func(ctx context.Context, zoneID string, rule *vinyldns.ACLRule) (*vinyldns.ZoneUpdateResponse, error)
+	ZoneACLRuleDeleteFunc               func(zoneID string, rule *vinyldns.ACLRule) (*vinyldns.ZoneUpdateResponse, error)
+	ZoneACLRuleDeleteWithContextFunc    func(ctx context.Context, zoneID string, rule *vinyldns.ACLRule) (*vinyldns.ZoneUpdateResponse, error)
+	ZoneCreateFunc                      func(z *vinyldns.Zone) (*vinyldns.ZoneUpdateResponse, error)
+	ZoneCreateWithContextFunc           func(ctx context.Context, z *vinyldns.Zone) (*vinyldns.ZoneUpdateResponse, error)
+	ZoneUpdateFunc                      func(z *vinyldns.Zone) (*vinyldns.ZoneUpdateResponse, error)
+	ZoneUpdateWithContextFunc           func(ctx context.Context, z *vinyldns.Zone) (*vinyldns.ZoneUpdateResponse, error)
+	ZoneDeleteFunc                      func(zoneID string) (*vinyldns.ZoneUpdateResponse, error)
+	ZoneDeleteWithContextFunc           func(ctx context.Context, zoneID string) (*vinyldns.ZoneUpdateResponse, error)
+	ZoneExistsFunc                      func(id string) (bool, error)
+	ZoneExistsWithContextFunc           func(ctx context.Context, id string) (bool, error)
+	ZoneNameExistsFunc                  func(name string) (bool, error)
+	ZoneNameExistsWithContextFunc       func(ctx context.Context, name string) (bool, error)
+	ZoneChangesFunc                     func(id string) (*vinyldns.ZoneChanges, error)
+	ZoneChangesWithContextFunc          func(ctx context.Context, id string) (*vinyldns.ZoneChanges, error)
+	ZoneChangesFailureFunc              func(filter vinyldns.ListFilter) (*vinyldns.ZoneChangeFailuresResponse, error)
+	ZoneChangesFailureWithContextFunc   func(ctx context.Context, filter vinyldns.ListFilter) (*vinyldns.ZoneChangeFailuresResponse, error)
+	ZoneChangesListAllFunc              func(zoneID string, filter vinyldns.ListFilter) ([]vinyldns.ZoneChange, error)
+	ZoneChangesListAllWithContextFunc   func(ctx context.Context, zoneID string, filter vinyldns.ListFilter) ([]vinyldns.ZoneChange, error)
+	ZoneChangesPagerFunc                func(ctx context.Context, zoneID string, filter vinyldns.ListFilter) *vinyldns.Pager[vinyldns.ZoneChange, string]
+	ZoneChangesIterFunc                 func(ctx context.Context, zoneID string, filter vinyldns.ListFilter) iter.Seq2[vinyldns.ZoneChange, error]
+	ZoneChangeFunc                      func(zoneID string, zoneChangeID string) (vinyldns.ZoneChange, error)
+	ZoneChangeWithContextFunc           func(ctx context.Context, zoneID string, zoneChangeID string) (vinyldns.ZoneChange, error)
+	ZoneSyncFunc                        func(zoneId string) (vinyldns.ZoneChange, error)
+	ZoneSyncWithContextFunc             func(ctx context.Context, zoneId string) (vinyldns.ZoneChange, error)
+	ZoneFileExportFunc                  func(zoneID string, w io.Writer) error
+	ZoneFileExportWithContextFunc       func(ctx context.Context, zoneID string, w io.Writer) error
+	ZoneFileImportFunc                  func(zoneID string, r io.Reader, opts vinyldns.ZoneFileParseOptions) (*vinyldns.RecordSetsImportResult, error)
+	ZoneFileImportWithContextFunc       func(ctx context.Context, zoneID string, r io.Reader, opts vinyldns.ZoneFileParseOptions) (*vinyldns.RecordSetsImportResult, error)
+	ZoneSnapshotFunc                    func(zoneID string) (*vinyldns.Snapshot, error)
+	ZoneSnapshotWithContextFunc         func(ctx context.Context, zoneID string) (*vinyldns.Snapshot, error)
+	ZoneSnapshotDiffFunc                func(before *vinyldns.Snapshot) (*vinyldns.SnapshotDiff, error)
+	ZoneSnapshotDiffWithContextFunc     func(ctx context.Context, before *vinyldns.Snapshot) (*vinyldns.SnapshotDiff, error)
+	WaitForZoneChangeFunc               func(ctx context.Context, zoneID string, zoneChangeID string, opts *vinyldns.WaitOptions) (*vinyldns.ZoneChange, error)
+	RecordSetCollectorFunc              func(zoneID string, limit int) (func() ([]vinyldns.RecordSet,
+
+		error), error)
+	RecordSetCollectorWithContextFunc func(ctx context.Context, zoneID string, limit int) (func() ([]vinyldns.RecordSet,
+
+		error), error)
+	RecordSetCollectorFilteredFunc func(zoneID string, filter vinyldns.ZoneRecordSetFilter) (func() ([]vinyldns.RecordSet,
+
+		error), error)
+	RecordSetCollectorFilteredWithContextFunc func(ctx context.Context, zoneID string, filter vinyldns.ZoneRecordSetFilter) (func() ([]vinyldns.RecordSet,
+
+		error), error)
+	RecordSetsFunc                                   func(id string) ([]vinyldns.RecordSet, error)
+	RecordSetsWithContextFunc                        func(ctx context.Context, id string) ([]vinyldns.RecordSet, error)
+	RecordSetsListAllFunc                            func(zoneID string, filter vinyldns.ListFilter) ([]vinyldns.RecordSet, error)
+	RecordSetsListAllWithContextFunc                 func(ctx context.Context, zoneID string, filter vinyldns.ListFilter) ([]vinyldns.RecordSet, error)
+	RecordSetsListAllFilteredFunc                    func(zoneID string, filter vinyldns.ZoneRecordSetFilter) ([]vinyldns.RecordSet, error)
+	RecordSetsListAllFilteredWithContextFunc         func(ctx context.Context, zoneID string, filter vinyldns.ZoneRecordSetFilter) ([]vinyldns.RecordSet, error)
+	RecordSetsPagerFunc                              func(ctx context.Context, zoneID string, filter vinyldns.ListFilter) *vinyldns.Pager[vinyldns.RecordSet, string]
+	RecordSetsIterFunc                               func(ctx context.Context, zoneID string, filter vinyldns.ListFilter) iter.Seq2[vinyldns.RecordSet, error]
+	RecordSetsPagerFilteredFunc                      func(ctx context.Context, zoneID string, filter vinyldns.ZoneRecordSetFilter) *vinyldns.Pager[vinyldns.RecordSet, string]
+	RecordSetsIterFilteredFunc                       func(ctx context.Context, zoneID string, filter vinyldns.ZoneRecordSetFilter) iter.Seq2[vinyldns.RecordSet, error]
+	RecordSetsGlobalFunc                             func(filter vinyldns.GlobalListFilter) ([]vinyldns.RecordSet, string, error)
+	RecordSetsGlobalWithContextFunc                  func(ctx context.Context, filter vinyldns.GlobalListFilter) ([]vinyldns.RecordSet, string, error)
+	RecordSetsGlobalListAllFunc                      func(filter vinyldns.GlobalListFilter) ([]vinyldns.RecordSet, error)
+	RecordSetsGlobalListAllWithContextFunc           func(ctx context.Context, filter vinyldns.GlobalListFilter) ([]vinyldns.RecordSet, error)
+	RecordSetsGlobalPagerFunc                        func(ctx context.Context, filter vinyldns.GlobalListFilter) *vinyldns.Pager[vinyldns.RecordSet, string]
+	RecordSetsGlobalIterFunc                         func(ctx context.Context, filter vinyldns.GlobalListFilter) iter.Seq2[vinyldns.RecordSet, error]
+	RecordSetFunc                                    func(zoneID string, recordSetID string) (vinyldns.RecordSet, error)
+	RecordSetWithContextFunc                         func(ctx context.Context, zoneID string, recordSetID string) (vinyldns.RecordSet, error)
+	RecordSetCountFunc                               func(zoneID string) (vinyldns.RecordSetCount, error)
+	RecordSetCountWithContextFunc                    func(ctx context.Context, zoneID string) (vinyldns.RecordSetCount, error)
+	RecordSetCreateFunc                              func(rs *vinyldns.RecordSet) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetCreateWithContextFunc                   func(ctx context.Context, rs *vinyldns.RecordSet) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetUpdateFunc                              func(rs *vinyldns.RecordSet) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetUpdateWithContextFunc                   func(ctx context.Context, rs *vinyldns.RecordSet) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferRequestFunc            func(rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferRequestWithContextFunc func(ctx context.Context, rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferApproveFunc            func(rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferApproveWithContextFunc func(ctx context.Context, rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferRejectFunc             func(rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferRejectWithContextFunc  func(ctx context.Context, rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferCancelFunc             func(rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetOwnershipTransferCancelWithContextFunc  func(ctx context.Context, rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetDeleteFunc                              func(zoneID string, recordSetID string) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetDeleteWithContextFunc                   func(ctx context.Context, zoneID string, recordSetID string) (*vinyldns.RecordSetUpdateResponse, error)
+	RecordSetChangesFunc                             func(zoneID string, f vinyldns.ListFilterRecordSetChanges) (*vinyldns.RecordSetChanges, error)
+	RecordSetChangesWithContextFunc                  func(ctx context.Context, zoneID string, f vinyldns.ListFilterRecordSetChanges) (*vinyldns.RecordSetChanges, error)
+	RecordSetChangeHistoryFunc                       func(f vinyldns.RecordSetChangeHistoryFilter) (*vinyldns.RecordSetChanges, error)
+	RecordSetChangeHistoryWithContextFunc            func(ctx context.Context, f vinyldns.RecordSetChangeHistoryFilter) (*vinyldns.RecordSetChanges, error)
+	RecordSetChangesListAllFunc                      func(zoneID string, filter vinyldns.ListFilterRecordSetChanges) ([]vinyldns.RecordSetChange, error)
+	RecordSetChangesListAllWithContextFunc           func(ctx context.Context, zoneID string, filter vinyldns.ListFilterRecordSetChanges) ([]vinyldns.RecordSetChange, error)
+	RecordSetChangesPagerFunc                        func(ctx context.Context, zoneID string, filter vinyldns.ListFilterRecordSetChanges) *vinyldns.Pager[vinyldns.RecordSetChange, int]
+	RecordSetChangesIterFunc                         func(ctx context.Context, zoneID string, filter vinyldns.ListFilterRecordSetChanges) iter.Seq2[vinyldns.RecordSetChange, error]
+	RecordSetChangeFunc                              func(zoneID string, recordSetID string, changeID string) (*vinyldns.RecordSetChange, error)
+	RecordSetChangeWithContextFunc                   func(ctx context.Context, zoneID string, recordSetID string, changeID string) (*vinyldns.RecordSetChange, error)
+	RecordSetChangesFailureFunc                      func(zoneID string, filter vinyldns.ListFilter) (*vinyldns.RecordSetChangeFailuresResponse, error)
+	RecordSetChangesFailureWithContextFunc           func(ctx context.Context, zoneID string, filter vinyldns.ListFilter) (*vinyldns.RecordSetChangeFailuresResponse, error)
+	RecordSetsImportFunc                             func(zoneID string, recordSets []vinyldns.RecordSet) (*vinyldns.RecordSetsImportResult, error)
+	RecordSetsImportWithContextFunc                  func(ctx context.Context, zoneID string, recordSets []vinyldns.RecordSet) (*vinyldns.RecordSetsImportResult, error)
+	RecordSetsPlanFunc                               func(zoneID string, desired []vinyldns.RecordSet, opts vinyldns.PlanOptions) (*vinyldns.Plan, error)
+	RecordSetsPlanWithContextFunc                    func(ctx context.Context, zoneID string, desired []vinyldns.RecordSet, opts vinyldns.PlanOptions) (*vinyldns.Plan, error)
+	RecordSetsApplyFunc                              func(plan *vinyldns.Plan, opts *vinyldns.WaitOptions) ([]vinyldns.RecordSetUpdateResponse, error)
+	RecordSetsApplyWithContextFunc                   func(ctx context.Context, plan *vinyldns.Plan, opts *vinyldns.WaitOptions) ([]vinyldns.RecordSetUpdateResponse, error)
+	WaitForRecordSetChangeFunc                       func(ctx context.Context, zoneID string, recordSetID string, changeID string, opts *vinyldns.WaitOptions) (*vinyldns.RecordSetChange, error)
+	GroupsFunc                                       func() ([]vinyldns.Group, error)
+	GroupsWithContextFunc                            func(ctx context.Context) ([]vinyldns.Group, error)
+	GroupsListAllFunc                                func(filter vinyldns.ListFilter) ([]vinyldns.Group, error)
+	GroupsListAllWithContextFunc                     func(ctx context.Context, filter vinyldns.ListFilter) ([]vinyldns.Group, error)
+	GroupsPagerFunc                                  func(ctx context.Context, filter vinyldns.ListFilter) *vinyldns.Pager[vinyldns.Group, string]
+	GroupsIterFunc                                   func(ctx context.Context, filter vinyldns.ListFilter) iter.Seq2[vinyldns.Group, error]
+	GroupCreateFunc                                  func(g *vinyldns.Group) (*vinyldns.Group, error)
+	GroupCreateWithContextFunc                       func(ctx context.Context, g *vinyldns.Group) (*vinyldns.Group, error)
+	GroupFunc                                        func(groupID string) (*vinyldns.Group, error)
+	GroupWithContextFunc                             func(ctx context.Context, groupID string) (*vinyldns.Group, error)
+	GroupDeleteFunc                                  func(groupID string) (*vinyldns.Group, error)
+	GroupDeleteWithContextFunc                       func(ctx context.Context, groupID string) (*vinyldns.Group, error)
+	GroupUpdateFunc                                  func(groupID string, g *vinyldns.Group) (*vinyldns.Group, error)
+	GroupUpdateWithContextFunc                       func(ctx context.Context, groupID string, g *vinyldns.Group) (*vinyldns.Group, error)
+	GroupAdminsFunc                                  func(groupID string) ([]vinyldns.User, error)
+	GroupAdminsWithContextFunc                       func(ctx context.Context, groupID string) ([]vinyldns.User, error)
+	GroupMembersFunc                                 func(groupID string) ([]vinyldns.User, error)
+	GroupMembersWithContextFunc                      func(ctx context.Context, groupID string) ([]vinyldns.User, error)
+	GroupActivityFunc                                func(groupID string) (*vinyldns.GroupChanges, error)
+	GroupActivityWithContextFunc                     func(ctx context.Context, groupID string) (*vinyldns.GroupChanges, error)
+	GroupChangeFunc                                  func(groupChangeID string) (*vinyldns.GroupChange, error)
+	GroupChangeWithContextFunc                       func(ctx context.Context, groupChangeID string) (*vinyldns.GroupChange, error)
+	GroupValidDomainsFunc                            func() ([]string, error)
+	GroupValidDomainsWithContextFunc                 func(ctx context.Context) ([]string, error)
+	BatchRecordChangesFunc                           func() ([]vinyldns.RecordChange, error)
+	BatchRecordChangesWithContextFunc                func(ctx context.Context) ([]vinyldns.RecordChange, error)
+	BatchRecordChangesListFunc                       func(filter vinyldns.BatchRecordChangesFilter) (*vinyldns.BatchChangeSummaries, error)
+	BatchRecordChangesListWithContextFunc            func(ctx context.Context, filter vinyldns.BatchRecordChangesFilter) (*vinyldns.BatchChangeSummaries, error)
+	BatchRecordChangesListAllFunc                    func(filter vinyldns.BatchRecordChangesFilter) ([]vinyldns.BatchChangeSummary, error)
+	BatchRecordChangesListAllWithContextFunc         func(ctx context.Context, filter vinyldns.BatchRecordChangesFilter) ([]vinyldns.BatchChangeSummary, error)
+	BatchRecordChangesPagerFunc                      func(ctx context.Context, filter vinyldns.BatchRecordChangesFilter) *vinyldns.Pager[vinyldns.BatchChangeSummary, int]
+	BatchRecordChangesIterFunc                       func(ctx context.Context, filter vinyldns.BatchRecordChangesFilter) iter.Seq2[vinyldns.BatchChangeSummary, error]
+	BatchRecordChangesScheduledFunc                  func(filter vinyldns.BatchRecordChangesFilter) ([]vinyldns.BatchChangeSummary, error)
+	BatchRecordChangesScheduledWithContextFunc       func(ctx context.Context, filter vinyldns.BatchRecordChangesFilter) ([]vinyldns.BatchChangeSummary, error)
+	BatchRecordChangeFunc                            func(changeID string) (*vinyldns.BatchRecordChange, error)
+	BatchRecordChangeWithContextFunc                 func(ctx context.Context, changeID string) (*vinyldns.BatchRecordChange, error)
+	BatchRecordChangeCreateFunc                      func(change *vinyldns.BatchRecordChange) (*vinyldns.BatchRecordChangeUpdateResponse, error)
+	BatchRecordChangeCreateWithContextFunc           func(ctx context.Context, change *vinyldns.BatchRecordChange) (*vinyldns.BatchRecordChangeUpdateResponse, error)
+	BatchRecordChangeSubmitFunc                      func(change *vinyldns.BatchRecordChange, opts *vinyldns.BatchChangeCreateOptions) (*vinyldns.BatchRecordChangeUpdateResponse, error)
+	BatchRecordChangeSubmitWithContextFunc           func(ctx context.Context, change *vinyldns.BatchRecordChange, opts *vinyldns.BatchChangeCreateOptions) (*vinyldns.BatchRecordChangeUpdateResponse, error)
+	BatchRecordChangeCreateChunkedFunc               func(change *vinyldns.BatchRecordChange, opts *vinyldns.ChunkOptions) (*vinyldns.ChunkedBatchChangeResult, error)
+	BatchRecordChangeCreateChunkedWithContextFunc    func(ctx context.Context, change *vinyldns.BatchRecordChange, opts *vinyldns.ChunkOptions) (*vinyldns.ChunkedBatchChangeResult, error)
+	BatchRecordChangeApproveFunc                     func(changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error)
+	BatchRecordChangeApproveWithContextFunc          func(ctx context.Context, changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error)
+	BatchRecordChangeRejectFunc                      func(changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error)
+	BatchRecordChangeRejectWithContextFunc           func(ctx context.Context, changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error)
+	BatchRecordChangeCancelFunc                      func(changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error)
+	BatchRecordChangeCancelWithContextFunc           func(ctx context.Context, changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error)
+	BatchRecordChangeRollbackPlanFunc                func(changeID string) (*vinyldns.BatchRollbackPlan, error)
+	BatchRecordChangeRollbackPlanWithContextFunc     func(ctx context.Context, changeID string) (*vinyldns.BatchRollbackPlan, error)
+	BatchRecordChangeRollbackFunc                    func(plan *vinyldns.BatchRollbackPlan) (*vinyldns.BatchRecordChangeUpdateResponse, error)
+	BatchRecordChangeRollbackWithContextFunc         func(ctx context.Context, plan *vinyldns.BatchRollbackPlan) (*vinyldns.BatchRecordChangeUpdateResponse, error)
+	WaitForBatchChangeFunc                           func(ctx context.Context, changeID string, opts *vinyldns.WaitOptions) (*vinyldns.BatchRecordChange, error)
+	UserFunc                                         func(userIdentifier string) (vinyldns.UserInfo, error)
+	UserWithContextFunc                              func(ctx context.Context, userIdentifier string) (vinyldns.UserInfo, error)
+	UserLockFunc                                     func(userID string) (vinyldns.UserInfo, error)
+	UserLockWithContextFunc                          func(ctx context.Context, userID string) (vinyldns.UserInfo, error)
+	UserUnlockFunc                                   func(userID string) (vinyldns.UserInfo, error)
+	UserUnlockWithContextFunc                        func(ctx context.Context, userID string) (vinyldns.UserInfo, error)
+	StatusFunc                                       func() (vinyldns.SystemStatus, error)
+	StatusWithContextFunc                            func(ctx context.Context) (vinyldns.SystemStatus, error)
+	StatusUpdateFunc                                 func(processingDisabled bool) (vinyldns.SystemStatus, error)
+	StatusUpdateWithContextFunc                      func(ctx context.Context, processingDisabled bool) (vinyldns.SystemStatus, error)
+	PingFunc                                         func() (string, error)
+	PingWithContextFunc                              func(ctx context.Context) (string, error)
+	HealthFunc                                       func() error
+	HealthWithContextFunc                            func(ctx context.Context) error
+	ColorFunc                                        func() (string, error)
+	ColorWithContextFunc                             func(ctx context.Context) (string, error)
+	MetricsPrometheusFunc                            func(names []string) (string, error)
+	MetricsPrometheusWithContextFunc                 func(ctx context.Context, names []string) (string, error)
+}
+
+// Zones records the call and invokes ZonesFunc.
+func (m *Mock) Zones() ([]vinyldns.Zone, error) {
+	m.record("Zones")
+	if m.ZonesFunc != nil {
+		return m.ZonesFunc()
+	}
+	if m.ZonesWithContextFunc != nil {
+		return m.ZonesWithContextFunc(context.Background())
+	}
+	var r0 []vinyldns.Zone
+	return r0, notMocked("Zones")
+}
+
+// ZonesWithContext records the call and invokes ZonesWithContextFunc.
+func (m *Mock) ZonesWithContext(ctx context.Context) ([]vinyldns.Zone, error) {
+	m.record("ZonesWithContext", ctx)
+	if m.ZonesWithContextFunc != nil {
+		return m.ZonesWithContextFunc(ctx)
+	}
+	var r0 []vinyldns.Zone
+	return r0, notMocked("ZonesWithContext")
+}
+
+// ZonesListAll records the call and invokes ZonesListAllFunc.
+func (m *Mock) ZonesListAll(filter vinyldns.ListFilter) ([]vinyldns.Zone, error) {
+	m.record("ZonesListAll", filter)
+	if m.ZonesListAllFunc != nil {
+		return m.ZonesListAllFunc(filter)
+	}
+	if m.ZonesListAllWithContextFunc != nil {
+		return m.ZonesListAllWithContextFunc(context.Background(), filter)
+	}
+	var r0 []vinyldns.Zone
+	return r0, notMocked("ZonesListAll")
+}
+
+// ZonesListAllWithContext records the call and invokes ZonesListAllWithContextFunc.
+func (m *Mock) ZonesListAllWithContext(ctx context.Context, filter vinyldns.ListFilter) ([]vinyldns.Zone, error) {
+	m.record("ZonesListAllWithContext", ctx, filter)
+	if m.ZonesListAllWithContextFunc != nil {
+		return m.ZonesListAllWithContextFunc(ctx, filter)
+	}
+	var r0 []vinyldns.Zone
+	return r0, notMocked("ZonesListAllWithContext")
+}
+
+// ZonesList records the call and invokes ZonesListFunc.
+func (m *Mock) ZonesList(filter vinyldns.ZoneListFilter) (*vinyldns.Zones, error) {
+	m.record("ZonesList", filter)
+	if m.ZonesListFunc != nil {
+		return m.ZonesListFunc(filter)
+	}
+	if m.ZonesListWithContextFunc != nil {
+		return m.ZonesListWithContextFunc(context.Background(), filter)
+	}
+	var r0 *vinyldns.Zones
+	return r0, notMocked("ZonesList")
+}
+
+// ZonesListWithContext records the call and invokes ZonesListWithContextFunc.
+func (m *Mock) ZonesListWithContext(ctx context.Context, filter vinyldns.ZoneListFilter) (*vinyldns.Zones, error) {
+	m.record("ZonesListWithContext", ctx, filter)
+	if m.ZonesListWithContextFunc != nil {
+		return m.ZonesListWithContextFunc(ctx, filter)
+	}
+	var r0 *vinyldns.Zones
+	return r0, notMocked("ZonesListWithContext")
+}
+
+// ZonesListAllFiltered records the call and invokes ZonesListAllFilteredFunc.
+func (m *Mock) ZonesListAllFiltered(filter vinyldns.ZoneListFilter) ([]vinyldns.Zone, error) {
+	m.record("ZonesListAllFiltered", filter)
+	if m.ZonesListAllFilteredFunc != nil {
+		return m.ZonesListAllFilteredFunc(filter)
+	}
+	if m.ZonesListAllFilteredWithContextFunc != nil {
+		return m.ZonesListAllFilteredWithContextFunc(context.Background(), filter)
+	}
+	var r0 []vinyldns.Zone
+	return r0, notMocked("ZonesListAllFiltered")
+}
+
+// ZonesListAllFilteredWithContext records the call and invokes ZonesListAllFilteredWithContextFunc.
+func (m *Mock) ZonesListAllFilteredWithContext(ctx context.Context, filter vinyldns.ZoneListFilter) ([]vinyldns.Zone, error) {
+	m.record("ZonesListAllFilteredWithContext", ctx, filter)
+	if m.ZonesListAllFilteredWithContextFunc != nil {
+		return m.ZonesListAllFilteredWithContextFunc(ctx, filter)
+	}
+	var r0 []vinyldns.Zone
+	return r0, notMocked("ZonesListAllFilteredWithContext")
+}
+
+// ZonesPager records the call and invokes ZonesPagerFunc.
+func (m *Mock) ZonesPager(ctx context.Context, filter vinyldns.ListFilter) *vinyldns.Pager[vinyldns.Zone, string] {
+	m.record("ZonesPager", ctx, filter)
+	if m.ZonesPagerFunc != nil {
+		return m.ZonesPagerFunc(ctx, filter)
+	}
+	return notMockedPager[vinyldns.Zone, string]("ZonesPager")
+}
+
+// ZonesIter records the call and invokes ZonesIterFunc.
+func (m *Mock) ZonesIter(ctx context.Context, filter vinyldns.ListFilter) iter.Seq2[vinyldns.Zone, error] {
+	m.record("ZonesIter", ctx, filter)
+	if m.ZonesIterFunc != nil {
+		return m.ZonesIterFunc(ctx, filter)
+	}
+	return notMockedSeq[vinyldns.Zone]("ZonesIter")
+}
+
+// ZonesPagerFiltered records the call and invokes ZonesPagerFilteredFunc.
+func (m *Mock) ZonesPagerFiltered(ctx context.Context, filter vinyldns.ZoneListFilter) *vinyldns.Pager[vinyldns.Zone, string] {
+	m.record("ZonesPagerFiltered", ctx, filter)
+	if m.ZonesPagerFilteredFunc != nil {
+		return m.ZonesPagerFilteredFunc(ctx, filter)
+	}
+	return notMockedPager[vinyldns.Zone, string]("ZonesPagerFiltered")
+}
+
+// ZonesIterFiltered records the call and invokes ZonesIterFilteredFunc.
+func (m *Mock) ZonesIterFiltered(ctx context.Context, filter vinyldns.ZoneListFilter) iter.Seq2[vinyldns.Zone, error] {
+	m.record("ZonesIterFiltered", ctx, filter)
+	if m.ZonesIterFilteredFunc != nil {
+		return m.ZonesIterFilteredFunc(ctx, filter)
+	}
+	return notMockedSeq[vinyldns.Zone]("ZonesIterFiltered")
+}
+
+// Zone records the call and invokes ZoneFunc.
+func (m *Mock) Zone(id string) (vinyldns.Zone, error) {
+	m.record("Zone", id)
+	if m.ZoneFunc != nil {
+		return m.ZoneFunc(id)
+	}
+	if m.ZoneWithContextFunc != nil {
+		return m.ZoneWithContextFunc(context.Background(), id)
+	}
+	var r0 vinyldns.Zone
+	return r0, notMocked("Zone")
+}
+
+// ZoneWithContext records the call and invokes ZoneWithContextFunc.
+func (m *Mock) ZoneWithContext(ctx context.Context, id string) (vinyldns.Zone, error) {
+	m.record("ZoneWithContext", ctx, id)
+	if m.ZoneWithContextFunc != nil {
+		return m.ZoneWithContextFunc(ctx, id)
+	}
+	var r0 vinyldns.Zone
+	return r0, notMocked("ZoneWithContext")
+}
+
+// ZoneDetails records the call and invokes ZoneDetailsFunc.
+func (m *Mock) ZoneDetails(id string) (vinyldns.ZoneDetails, error) {
+	m.record("ZoneDetails", id)
+	if m.ZoneDetailsFunc != nil {
+		return m.ZoneDetailsFunc(id)
+	}
+	if m.ZoneDetailsWithContextFunc != nil {
+		return m.ZoneDetailsWithContextFunc(context.Background(), id)
+	}
+	var r0 vinyldns.ZoneDetails
+	return r0, notMocked("ZoneDetails")
+}
+
+// ZoneDetailsWithContext records the call and invokes ZoneDetailsWithContextFunc.
+func (m *Mock) ZoneDetailsWithContext(ctx context.Context, id string) (vinyldns.ZoneDetails, error) {
+	m.record("ZoneDetailsWithContext", ctx, id)
+	if m.ZoneDetailsWithContextFunc != nil {
+		return m.ZoneDetailsWithContextFunc(ctx, id)
+	}
+	var r0 vinyldns.ZoneDetails
+	return r0, notMocked("ZoneDetailsWithContext")
+}
+
+// ZoneBackendIDs records the call and invokes ZoneBackendIDsFunc.
+func (m *Mock) ZoneBackendIDs() ([]string, error) {
+	m.record("ZoneBackendIDs")
+	if m.ZoneBackendIDsFunc != nil {
+		return m.ZoneBackendIDsFunc()
+	}
+	if m.ZoneBackendIDsWithContextFunc != nil {
+		return m.ZoneBackendIDsWithContextFunc(context.Background())
+	}
+	var r0 []string
+	return r0, notMocked("ZoneBackendIDs")
+}
+
+// ZoneBackendIDsWithContext records the call and invokes ZoneBackendIDsWithContextFunc.
+func (m *Mock) ZoneBackendIDsWithContext(ctx context.Context) ([]string, error) {
+	m.record("ZoneBackendIDsWithContext", ctx)
+	if m.ZoneBackendIDsWithContextFunc != nil {
+		return m.ZoneBackendIDsWithContextFunc(ctx)
+	}
+	var r0 []string
+	return r0, notMocked("ZoneBackendIDsWithContext")
+}
+
+// ZoneByID records the call and invokes ZoneByIDFunc.
+func (m *Mock) ZoneByID(id string) (vinyldns.Zone, error) {
+	m.record("ZoneByID", id)
+	if m.ZoneByIDFunc != nil {
+		return m.ZoneByIDFunc(id)
+	}
+	if m.ZoneByIDWithContextFunc != nil {
+		return m.ZoneByIDWithContextFunc(context.Background(), id)
+	}
+	var r0 vinyldns.Zone
+	return r0, notMocked("ZoneByID")
+}
+
+// ZoneByIDWithContext records the call and invokes ZoneByIDWithContextFunc.
+func (m *Mock) ZoneByIDWithContext(ctx context.Context, id string) (vinyldns.Zone, error) {
+	m.record("ZoneByIDWithContext", ctx, id)
+	if m.ZoneByIDWithContextFunc != nil {
+		return m.ZoneByIDWithContextFunc(ctx, id)
+	}
+	var r0 vinyldns.Zone
+	return r0, notMocked("ZoneByIDWithContext")
+}
+
+// ZoneByName records the call and invokes ZoneByNameFunc.
+func (m *Mock) ZoneByName(name string) (vinyldns.Zone, error) {
+	m.record("ZoneByName", name)
+	if m.ZoneByNameFunc != nil {
+		return m.ZoneByNameFunc(name)
+	}
+	if m.ZoneByNameWithContextFunc != nil {
+		return m.ZoneByNameWithContextFunc(context.Background(), name)
+	}
+	var r0 vinyldns.Zone
+	return r0, notMocked("ZoneByName")
+}
+
+// ZoneByNameWithContext records the call and invokes ZoneByNameWithContextFunc.
+func (m *Mock) ZoneByNameWithContext(ctx context.Context, name string) (vinyldns.Zone, error) {
+	m.record("ZoneByNameWithContext", ctx, name)
+	if m.ZoneByNameWithContextFunc != nil {
+		return m.ZoneByNameWithContextFunc(ctx, name)
+	}
+	var r0 vinyldns.Zone
+	return r0, notMocked("ZoneByNameWithContext")
+}
+
+// ZonesDeleted records the call and invokes ZonesDeletedFunc.
+func (m *Mock) ZonesDeleted(filter vinyldns.DeletedZonesFilter) (*vinyldns.DeletedZonesResponse, error) {
+	m.record("ZonesDeleted", filter)
+	if m.ZonesDeletedFunc != nil {
+		return m.ZonesDeletedFunc(filter)
+	}
+	if m.ZonesDeletedWithContextFunc != nil {
+		return m.ZonesDeletedWithContextFunc(context.Background(), filter)
+	}
+	var r0 *vinyldns.DeletedZonesResponse
+	return r0, notMocked("ZonesDeleted")
+}
+
+// ZonesDeletedWithContext records the call and invokes ZonesDeletedWithContextFunc.
+func (m *Mock) ZonesDeletedWithContext(ctx context.Context, filter vinyldns.DeletedZonesFilter) (*vinyldns.DeletedZonesResponse, error) {
+	m.record("ZonesDeletedWithContext", ctx, filter)
+	if m.ZonesDeletedWithContextFunc != nil {
+		return m.ZonesDeletedWithContextFunc(ctx, filter)
+	}
+	var r0 *vinyldns.DeletedZonesResponse
+	return r0, notMocked("ZonesDeletedWithContext")
+}
+
+// ZoneACLRuleCreate records the call and invokes ZoneACLRuleCreateFunc.
+func (m *Mock) ZoneACLRuleCreate(zoneID string, rule *vinyldns.ACLRule) (*vinyldns.ZoneUpdateResponse, error) {
+	m.record("ZoneACLRuleCreate", zoneID, rule)
+	if m.ZoneACLRuleCreateFunc != nil {
+		return m.ZoneACLRuleCreateFunc(zoneID, rule)
+	}
+	if m.ZoneACLRuleCreateWithContextFunc != nil {
+		return m.ZoneACLRuleCreateWithContextFunc(context.Background(), zoneID, rule)
+	}
+	var r0 *vinyldns.ZoneUpdateResponse
+	return r0, notMocked("ZoneACLRuleCreate")
+}
+
+// ZoneACLRuleCreateWithContext records the call and invokes ZoneACLRuleCreateWithContextFunc.
+func (m *Mock) ZoneACLRuleCreateWithContext(ctx context.Context, zoneID string, rule *vinyldns.ACLRule) (*vinyldns.ZoneUpdateResponse, error) {
+	m.record("ZoneACLRuleCreateWithContext", ctx, zoneID, rule)
+	if m.ZoneACLRuleCreateWithContextFunc != nil {
+		return m.ZoneACLRuleCreateWithContextFunc(ctx, zoneID, rule)
+	}
+	var r0 *vinyldns.ZoneUpdateResponse
+	return r0, notMocked("ZoneACLRuleCreateWithContext")
+}
+
+// ZoneACLRuleDelete records the call and invokes ZoneACLRuleDeleteFunc.
+func (m *Mock) ZoneACLRuleDelete(zoneID string, rule *vinyldns.ACLRule) (*vinyldns.ZoneUpdateResponse, error) {
+	m.record("ZoneACLRuleDelete", zoneID, rule)
+	if m.ZoneACLRuleDeleteFunc != nil {
+		return m.ZoneACLRuleDeleteFunc(zoneID, rule)
+	}
+	if m.ZoneACLRuleDeleteWithContextFunc != nil {
+		return m.ZoneACLRuleDeleteWithContextFunc(context.Background(), zoneID, rule)
+	}
+	var r0 *vinyldns.ZoneUpdateResponse
+	return r0, notMocked("ZoneACLRuleDelete")
+}
+
+// ZoneACLRuleDeleteWithContext records the call and invokes ZoneACLRuleDeleteWithContextFunc.
+func (m *Mock) ZoneACLRuleDeleteWithContext(ctx context.Context, zoneID string, rule *vinyldns.ACLRule) (*vinyldns.ZoneUpdateResponse, error) {
+	m.record("ZoneACLRuleDeleteWithContext", ctx, zoneID, rule)
+	if m.ZoneACLRuleDeleteWithContextFunc != nil {
+		return m.ZoneACLRuleDeleteWithContextFunc(ctx, zoneID, rule)
+	}
+	var r0 *vinyldns.ZoneUpdateResponse
+	return r0, notMocked("ZoneACLRuleDeleteWithContext")
+}
+
+// ZoneCreate records the call and invokes ZoneCreateFunc.
+func (m *Mock) ZoneCreate(z *vinyldns.Zone) (*vinyldns.ZoneUpdateResponse, error) {
+	m.record("ZoneCreate", z)
+	if m.ZoneCreateFunc != nil {
+		return m.ZoneCreateFunc(z)
+	}
+	if m.ZoneCreateWithContextFunc != nil {
+		return m.ZoneCreateWithContextFunc(context.Background(), z)
+	}
+	var r0 *vinyldns.ZoneUpdateResponse
+	return r0, notMocked("ZoneCreate")
+}
+
+// ZoneCreateWithContext records the call and invokes ZoneCreateWithContextFunc.
+func (m *Mock) ZoneCreateWithContext(ctx context.Context, z *vinyldns.Zone) (*vinyldns.ZoneUpdateResponse, error) {
+	m.record("ZoneCreateWithContext", ctx, z)
+	if m.ZoneCreateWithContextFunc != nil {
+		return m.ZoneCreateWithContextFunc(ctx, z)
+	}
+	var r0 *vinyldns.ZoneUpdateResponse
+	return r0, notMocked("ZoneCreateWithContext")
+}
+
+// ZoneUpdate records the call and invokes ZoneUpdateFunc.
+func (m *Mock) ZoneUpdate(z *vinyldns.Zone) (*vinyldns.ZoneUpdateResponse, error) {
+	m.record("ZoneUpdate", z)
+	if m.ZoneUpdateFunc != nil {
+		return m.ZoneUpdateFunc(z)
+	}
+	if m.ZoneUpdateWithContextFunc != nil {
+		return m.ZoneUpdateWithContextFunc(context.Background(), z)
+	}
+	var r0 *vinyldns.ZoneUpdateResponse
+	return r0, notMocked("ZoneUpdate")
+}
+
+// ZoneUpdateWithContext records the call and invokes ZoneUpdateWithContextFunc.
+func (m *Mock) ZoneUpdateWithContext(ctx context.Context, z *vinyldns.Zone) (*vinyldns.ZoneUpdateResponse, error) {
+	m.record("ZoneUpdateWithContext", ctx, z)
+	if m.ZoneUpdateWithContextFunc != nil {
+		return m.ZoneUpdateWithContextFunc(ctx, z)
+	}
+	var r0 *vinyldns.ZoneUpdateResponse
+	return r0, notMocked("ZoneUpdateWithContext")
+}
+
+// ZoneDelete records the call and invokes ZoneDeleteFunc.
+func (m *Mock) ZoneDelete(zoneID string) (*vinyldns.ZoneUpdateResponse, error) {
+	m.record("ZoneDelete", zoneID)
+	if m.ZoneDeleteFunc != nil {
+		return m.ZoneDeleteFunc(zoneID)
+	}
+	if m.ZoneDeleteWithContextFunc != nil {
+		return m.ZoneDeleteWithContextFunc(context.Background(), zoneID)
+	}
+	var r0 *vinyldns.ZoneUpdateResponse
+	return r0, notMocked("ZoneDelete")
+}
+
+// ZoneDeleteWithContext records the call and invokes ZoneDeleteWithContextFunc.
+func (m *Mock) ZoneDeleteWithContext(ctx context.Context, zoneID string) (*vinyldns.ZoneUpdateResponse, error) {
+	m.record("ZoneDeleteWithContext", ctx, zoneID)
+	if m.ZoneDeleteWithContextFunc != nil {
+		return m.ZoneDeleteWithContextFunc(ctx, zoneID)
+	}
+	var r0 *vinyldns.ZoneUpdateResponse
+	return r0, notMocked("ZoneDeleteWithContext")
+}
+
+// ZoneExists records the call and invokes ZoneExistsFunc.
+func (m *Mock) ZoneExists(id string) (bool, error) {
+	m.record("ZoneExists", id)
+	if m.ZoneExistsFunc != nil {
+		return m.ZoneExistsFunc(id)
+	}
+	if m.ZoneExistsWithContextFunc != nil {
+		return m.ZoneExistsWithContextFunc(context.Background(), id)
+	}
+	var r0 bool
+	return r0, notMocked("ZoneExists")
+}
+
+// ZoneExistsWithContext records the call and invokes ZoneExistsWithContextFunc.
+func (m *Mock) ZoneExistsWithContext(ctx context.Context, id string) (bool, error) {
+	m.record("ZoneExistsWithContext", ctx, id)
+	if m.ZoneExistsWithContextFunc != nil {
+		return m.ZoneExistsWithContextFunc(ctx, id)
+	}
+	var r0 bool
+	return r0, notMocked("ZoneExistsWithContext")
+}
+
+// ZoneNameExists records the call and invokes ZoneNameExistsFunc.
+func (m *Mock) ZoneNameExists(name string) (bool, error) {
+	m.record("ZoneNameExists", name)
+	if m.ZoneNameExistsFunc != nil {
+		return m.ZoneNameExistsFunc(name)
+	}
+	if m.ZoneNameExistsWithContextFunc != nil {
+		return m.ZoneNameExistsWithContextFunc(context.Background(), name)
+	}
+	var r0 bool
+	return r0, notMocked("ZoneNameExists")
+}
+
+// ZoneNameExistsWithContext records the call and invokes ZoneNameExistsWithContextFunc.
+func (m *Mock) ZoneNameExistsWithContext(ctx context.Context, name string) (bool, error) {
+	m.record("ZoneNameExistsWithContext", ctx, name)
+	if m.ZoneNameExistsWithContextFunc != nil {
+		return m.ZoneNameExistsWithContextFunc(ctx, name)
+	}
+	var r0 bool
+	return r0, notMocked("ZoneNameExistsWithContext")
+}
+
+// ZoneChanges records the call and invokes ZoneChangesFunc.
+func (m *Mock) ZoneChanges(id string) (*vinyldns.ZoneChanges, error) {
+	m.record("ZoneChanges", id)
+	if m.ZoneChangesFunc != nil {
+		return m.ZoneChangesFunc(id)
+	}
+	if m.ZoneChangesWithContextFunc != nil {
+		return m.ZoneChangesWithContextFunc(context.Background(), id)
+	}
+	var r0 *vinyldns.ZoneChanges
+	return r0, notMocked("ZoneChanges")
+}
+
+// ZoneChangesWithContext records the call and invokes ZoneChangesWithContextFunc.
+func (m *Mock) ZoneChangesWithContext(ctx context.Context, id string) (*vinyldns.ZoneChanges, error) {
+	m.record("ZoneChangesWithContext", ctx, id)
+	if m.ZoneChangesWithContextFunc != nil {
+		return m.ZoneChangesWithContextFunc(ctx, id)
+	}
+	var r0 *vinyldns.ZoneChanges
+	return r0, notMocked("ZoneChangesWithContext")
+}
+
+// ZoneChangesFailure records the call and invokes ZoneChangesFailureFunc.
+func (m *Mock) ZoneChangesFailure(filter vinyldns.ListFilter) (*vinyldns.ZoneChangeFailuresResponse, error) {
+	m.record("ZoneChangesFailure", filter)
+	if m.ZoneChangesFailureFunc != nil {
+		return m.ZoneChangesFailureFunc(filter)
+	}
+	if m.ZoneChangesFailureWithContextFunc != nil {
+		return m.ZoneChangesFailureWithContextFunc(context.Background(), filter)
+	}
+	var r0 *vinyldns.ZoneChangeFailuresResponse
+	return r0, notMocked("ZoneChangesFailure")
+}
+
+// ZoneChangesFailureWithContext records the call and invokes ZoneChangesFailureWithContextFunc.
+func (m *Mock) ZoneChangesFailureWithContext(ctx context.Context, filter vinyldns.ListFilter) (*vinyldns.ZoneChangeFailuresResponse, error) {
+	m.record("ZoneChangesFailureWithContext", ctx, filter)
+	if m.ZoneChangesFailureWithContextFunc != nil {
+		return m.ZoneChangesFailureWithContextFunc(ctx, filter)
+	}
+	var r0 *vinyldns.ZoneChangeFailuresResponse
+	return r0, notMocked("ZoneChangesFailureWithContext")
+}
+
+// ZoneChangesListAll records the call and invokes ZoneChangesListAllFunc.
+func (m *Mock) ZoneChangesListAll(zoneID string, filter vinyldns.ListFilter) ([]vinyldns.ZoneChange, error) {
+	m.record("ZoneChangesListAll", zoneID, filter)
+	if m.ZoneChangesListAllFunc != nil {
+		return m.ZoneChangesListAllFunc(zoneID, filter)
+	}
+	if m.ZoneChangesListAllWithContextFunc != nil {
+		return m.ZoneChangesListAllWithContextFunc(context.Background(), zoneID, filter)
+	}
+	var r0 []vinyldns.ZoneChange
+	return r0, notMocked("ZoneChangesListAll")
+}
+
+// ZoneChangesListAllWithContext records the call and invokes ZoneChangesListAllWithContextFunc.
+func (m *Mock) ZoneChangesListAllWithContext(ctx context.Context, zoneID string, filter vinyldns.ListFilter) ([]vinyldns.ZoneChange, error) {
+	m.record("ZoneChangesListAllWithContext", ctx, zoneID, filter)
+	if m.ZoneChangesListAllWithContextFunc != nil {
+		return m.ZoneChangesListAllWithContextFunc(ctx, zoneID, filter)
+	}
+	var r0 []vinyldns.ZoneChange
+	return r0, notMocked("ZoneChangesListAllWithContext")
+}
+
+// ZoneChangesPager records the call and invokes ZoneChangesPagerFunc.
+func (m *Mock) ZoneChangesPager(ctx context.Context, zoneID string, filter vinyldns.ListFilter) *vinyldns.Pager[vinyldns.ZoneChange, string] {
+	m.record("ZoneChangesPager", ctx, zoneID, filter)
+	if m.ZoneChangesPagerFunc != nil {
+		return m.ZoneChangesPagerFunc(ctx, zoneID, filter)
+	}
+	return notMockedPager[vinyldns.ZoneChange, string]("ZoneChangesPager")
+}
+
+// ZoneChangesIter records the call and invokes ZoneChangesIterFunc.
+func (m *Mock) ZoneChangesIter(ctx context.Context, zoneID string, filter vinyldns.ListFilter) iter.Seq2[vinyldns.ZoneChange, error] {
+	m.record("ZoneChangesIter", ctx, zoneID, filter)
+	if m.ZoneChangesIterFunc != nil {
+		return m.ZoneChangesIterFunc(ctx, zoneID, filter)
+	}
+	return notMockedSeq[vinyldns.ZoneChange]("ZoneChangesIter")
+}
+
+// ZoneChange records the call and invokes ZoneChangeFunc.
+func (m *Mock) ZoneChange(zoneID string, zoneChangeID string) (vinyldns.ZoneChange, error) {
+	m.record("ZoneChange", zoneID, zoneChangeID)
+	if m.ZoneChangeFunc != nil {
+		return m.ZoneChangeFunc(zoneID, zoneChangeID)
+	}
+	if m.ZoneChangeWithContextFunc != nil {
+		return m.ZoneChangeWithContextFunc(context.Background(), zoneID, zoneChangeID)
+	}
+	var r0 vinyldns.ZoneChange
+	return r0, notMocked("ZoneChange")
+}
+
+// ZoneChangeWithContext records the call and invokes ZoneChangeWithContextFunc.
+func (m *Mock) ZoneChangeWithContext(ctx context.Context, zoneID string, zoneChangeID string) (vinyldns.ZoneChange, error) {
+	m.record("ZoneChangeWithContext", ctx, zoneID, zoneChangeID)
+	if m.ZoneChangeWithContextFunc != nil {
+		return m.ZoneChangeWithContextFunc(ctx, zoneID, zoneChangeID)
+	}
+	var r0 vinyldns.ZoneChange
+	return r0, notMocked("ZoneChangeWithContext")
+}
+
+// ZoneSync records the call and invokes ZoneSyncFunc.
+func (m *Mock) ZoneSync(zoneId string) (vinyldns.ZoneChange, error) {
+	m.record("ZoneSync", zoneId)
+	if m.ZoneSyncFunc != nil {
+		return m.ZoneSyncFunc(zoneId)
+	}
+	if m.ZoneSyncWithContextFunc != nil {
+		return m.ZoneSyncWithContextFunc(context.Background(), zoneId)
+	}
+	var r0 vinyldns.ZoneChange
+	return r0, notMocked("ZoneSync")
+}
+
+// ZoneSyncWithContext records the call and invokes ZoneSyncWithContextFunc.
+func (m *Mock) ZoneSyncWithContext(ctx context.Context, zoneId string) (vinyldns.ZoneChange, error) {
+	m.record("ZoneSyncWithContext", ctx, zoneId)
+	if m.ZoneSyncWithContextFunc != nil {
+		return m.ZoneSyncWithContextFunc(ctx, zoneId)
+	}
+	var r0 vinyldns.ZoneChange
+	return r0, notMocked("ZoneSyncWithContext")
+}
+
+// ZoneFileExport records the call and invokes ZoneFileExportFunc.
+func (m *Mock) ZoneFileExport(zoneID string, w io.Writer) error {
+	m.record("ZoneFileExport", zoneID, w)
+	if m.ZoneFileExportFunc != nil {
+		return m.ZoneFileExportFunc(zoneID, w)
+	}
+	if m.ZoneFileExportWithContextFunc != nil {
+		return m.ZoneFileExportWithContextFunc(context.Background(), zoneID, w)
+	}
+	return notMocked("ZoneFileExport")
+}
+
+// ZoneFileExportWithContext records the call and invokes ZoneFileExportWithContextFunc.
+func (m *Mock) ZoneFileExportWithContext(ctx context.Context, zoneID string, w io.Writer) error {
+	m.record("ZoneFileExportWithContext", ctx, zoneID, w)
+	if m.ZoneFileExportWithContextFunc != nil {
+		return m.ZoneFileExportWithContextFunc(ctx, zoneID, w)
+	}
+	return notMocked("ZoneFileExportWithContext")
+}
+
+// ZoneFileImport records the call and invokes ZoneFileImportFunc.
+func (m *Mock) ZoneFileImport(zoneID string, r io.Reader, opts vinyldns.ZoneFileParseOptions) (*vinyldns.RecordSetsImportResult, error) {
+	m.record("ZoneFileImport", zoneID, r, opts)
+	if m.ZoneFileImportFunc != nil {
+		return m.ZoneFileImportFunc(zoneID, r, opts)
+	}
+	if m.ZoneFileImportWithContextFunc != nil {
+		return m.ZoneFileImportWithContextFunc(context.Background(), zoneID, r, opts)
+	}
+	var r0 *vinyldns.RecordSetsImportResult
+	return r0, notMocked("ZoneFileImport")
+}
+
+// ZoneFileImportWithContext records the call and invokes ZoneFileImportWithContextFunc.
+func (m *Mock) ZoneFileImportWithContext(ctx context.Context, zoneID string, r io.Reader, opts vinyldns.ZoneFileParseOptions) (*vinyldns.RecordSetsImportResult, error) {
+	m.record("ZoneFileImportWithContext", ctx, zoneID, r, opts)
+	if m.ZoneFileImportWithContextFunc != nil {
+		return m.ZoneFileImportWithContextFunc(ctx, zoneID, r, opts)
+	}
+	var r0 *vinyldns.RecordSetsImportResult
+	return r0, notMocked("ZoneFileImportWithContext")
+}
+
+// ZoneSnapshot records the call and invokes ZoneSnapshotFunc.
+func (m *Mock) ZoneSnapshot(zoneID string) (*vinyldns.Snapshot, error) {
+	m.record("ZoneSnapshot", zoneID)
+	if m.ZoneSnapshotFunc != nil {
+		return m.ZoneSnapshotFunc(zoneID)
+	}
+	if m.ZoneSnapshotWithContextFunc != nil {
+		return m.ZoneSnapshotWithContextFunc(context.Background(), zoneID)
+	}
+	var r0 *vinyldns.Snapshot
+	return r0, notMocked("ZoneSnapshot")
+}
+
+// ZoneSnapshotWithContext records the call and invokes ZoneSnapshotWithContextFunc.
+func (m *Mock) ZoneSnapshotWithContext(ctx context.Context, zoneID string) (*vinyldns.Snapshot, error) {
+	m.record("ZoneSnapshotWithContext", ctx, zoneID)
+	if m.ZoneSnapshotWithContextFunc != nil {
+		return m.ZoneSnapshotWithContextFunc(ctx, zoneID)
+	}
+	var r0 *vinyldns.Snapshot
+	return r0, notMocked("ZoneSnapshotWithContext")
+}
+
+// ZoneSnapshotDiff records the call and invokes ZoneSnapshotDiffFunc.
+func (m *Mock) ZoneSnapshotDiff(before *vinyldns.Snapshot) (*vinyldns.SnapshotDiff, error) {
+	m.record("ZoneSnapshotDiff", before)
+	if m.ZoneSnapshotDiffFunc != nil {
+		return m.ZoneSnapshotDiffFunc(before)
+	}
+	if m.ZoneSnapshotDiffWithContextFunc != nil {
+		return m.ZoneSnapshotDiffWithContextFunc(context.Background(), before)
+	}
+	var r0 *vinyldns.SnapshotDiff
+	return r0, notMocked("ZoneSnapshotDiff")
+}
+
+// ZoneSnapshotDiffWithContext records the call and invokes ZoneSnapshotDiffWithContextFunc.
+func (m *Mock) ZoneSnapshotDiffWithContext(ctx context.Context, before *vinyldns.Snapshot) (*vinyldns.SnapshotDiff, error) {
+	m.record("ZoneSnapshotDiffWithContext", ctx, before)
+	if m.ZoneSnapshotDiffWithContextFunc != nil {
+		return m.ZoneSnapshotDiffWithContextFunc(ctx, before)
+	}
+	var r0 *vinyldns.SnapshotDiff
+	return r0, notMocked("ZoneSnapshotDiffWithContext")
+}
+
+// WaitForZoneChange records the call and invokes WaitForZoneChangeFunc.
+func (m *Mock) WaitForZoneChange(ctx context.Context, zoneID string, zoneChangeID string, opts *vinyldns.WaitOptions) (*vinyldns.ZoneChange, error) {
+	m.record("WaitForZoneChange", ctx, zoneID, zoneChangeID, opts)
+	if m.WaitForZoneChangeFunc != nil {
+		return m.WaitForZoneChangeFunc(ctx, zoneID, zoneChangeID, opts)
+	}
+	var r0 *vinyldns.ZoneChange
+	return r0, notMocked("WaitForZoneChange")
+}
+
+// RecordSetCollector records the call and invokes RecordSetCollectorFunc.
+func (m *Mock) RecordSetCollector(zoneID string, limit int) (func() ([]vinyldns.RecordSet,
+
+	error), error) {
+	m.record("RecordSetCollector", zoneID, limit)
+	if m.RecordSetCollectorFunc != nil {
+		return m.RecordSetCollectorFunc(zoneID, limit)
+	}
+	if m.RecordSetCollectorWithContextFunc != nil {
+		return m.RecordSetCollectorWithContextFunc(context.Background(), zoneID, limit)
+	}
+	var r0 func() ([]vinyldns.RecordSet,
+
+		error)
+	return r0, notMocked("RecordSetCollector")
+}
+
+// RecordSetCollectorWithContext records the call and invokes RecordSetCollectorWithContextFunc.
+func (m *Mock) RecordSetCollectorWithContext(ctx context.Context, zoneID string, limit int) (func() ([]vinyldns.RecordSet,
+
+	error), error) {
+	m.record("RecordSetCollectorWithContext", ctx, zoneID, limit)
+	if m.RecordSetCollectorWithContextFunc != nil {
+		return m.RecordSetCollectorWithContextFunc(ctx, zoneID, limit)
+	}
+	var r0 func() ([]vinyldns.RecordSet,
+
+		error)
+	return r0, notMocked("RecordSetCollectorWithContext")
+}
+
+// RecordSetCollectorFiltered records the call and invokes RecordSetCollectorFilteredFunc.
+func (m *Mock) RecordSetCollectorFiltered(zoneID string, filter vinyldns.ZoneRecordSetFilter) (func() ([]vinyldns.RecordSet,
+
+	error), error) {
+	m.record("RecordSetCollectorFiltered", zoneID, filter)
+	if m.RecordSetCollectorFilteredFunc != nil {
+		return m.RecordSetCollectorFilteredFunc(zoneID, filter)
+	}
+	if m.RecordSetCollectorFilteredWithContextFunc != nil {
+		return m.RecordSetCollectorFilteredWithContextFunc(context.Background(), zoneID, filter)
+	}
+	var r0 func() ([]vinyldns.RecordSet,
+
+		error)
+	return r0, notMocked("RecordSetCollectorFiltered")
+}
+
+// RecordSetCollectorFilteredWithContext records the call and invokes RecordSetCollectorFilteredWithContextFunc.
+func (m *Mock) RecordSetCollectorFilteredWithContext(ctx context.Context, zoneID string, filter vinyldns.ZoneRecordSetFilter) (func() ([]vinyldns.RecordSet,
+
+	error), error) {
+	m.record("RecordSetCollectorFilteredWithContext", ctx, zoneID, filter)
+	if m.RecordSetCollectorFilteredWithContextFunc != nil {
+		return m.RecordSetCollectorFilteredWithContextFunc(ctx, zoneID, filter)
+	}
+	var r0 func() ([]vinyldns.RecordSet,
+
+		error)
+	return r0, notMocked("RecordSetCollectorFilteredWithContext")
+}
+
+// RecordSets records the call and invokes RecordSetsFunc.
+func (m *Mock) RecordSets(id string) ([]vinyldns.RecordSet, error) {
+	m.record("RecordSets", id)
+	if m.RecordSetsFunc != nil {
+		return m.RecordSetsFunc(id)
+	}
+	if m.RecordSetsWithContextFunc != nil {
+		return m.RecordSetsWithContextFunc(context.Background(), id)
+	}
+	var r0 []vinyldns.RecordSet
+	return r0, notMocked("RecordSets")
+}
+
+// RecordSetsWithContext records the call and invokes RecordSetsWithContextFunc.
+func (m *Mock) RecordSetsWithContext(ctx context.Context, id string) ([]vinyldns.RecordSet, error) {
+	m.record("RecordSetsWithContext", ctx, id)
+	if m.RecordSetsWithContextFunc != nil {
+		return m.RecordSetsWithContextFunc(ctx, id)
+	}
+	var r0 []vinyldns.RecordSet
+	return r0, notMocked("RecordSetsWithContext")
+}
+
+// RecordSetsListAll records the call and invokes RecordSetsListAllFunc.
+func (m *Mock) RecordSetsListAll(zoneID string, filter vinyldns.ListFilter) ([]vinyldns.RecordSet, error) {
+	m.record("RecordSetsListAll", zoneID, filter)
+	if m.RecordSetsListAllFunc != nil {
+		return m.RecordSetsListAllFunc(zoneID, filter)
+	}
+	if m.RecordSetsListAllWithContextFunc != nil {
+		return m.RecordSetsListAllWithContextFunc(context.Background(), zoneID, filter)
+	}
+	var r0 []vinyldns.RecordSet
+	return r0, notMocked("RecordSetsListAll")
+}
+
+// RecordSetsListAllWithContext records the call and invokes RecordSetsListAllWithContextFunc.
+func (m *Mock) RecordSetsListAllWithContext(ctx context.Context, zoneID string, filter vinyldns.ListFilter) ([]vinyldns.RecordSet, error) {
+	m.record("RecordSetsListAllWithContext", ctx, zoneID, filter)
+	if m.RecordSetsListAllWithContextFunc != nil {
+		return m.RecordSetsListAllWithContextFunc(ctx, zoneID, filter)
+	}
+	var r0 []vinyldns.RecordSet
+	return r0, notMocked("RecordSetsListAllWithContext")
+}
+
+// RecordSetsListAllFiltered records the call and invokes RecordSetsListAllFilteredFunc.
+func (m *Mock) RecordSetsListAllFiltered(zoneID string, filter vinyldns.ZoneRecordSetFilter) ([]vinyldns.RecordSet, error) {
+	m.record("RecordSetsListAllFiltered", zoneID, filter)
+	if m.RecordSetsListAllFilteredFunc != nil {
+		return m.RecordSetsListAllFilteredFunc(zoneID, filter)
+	}
+	if m.RecordSetsListAllFilteredWithContextFunc != nil {
+		return m.RecordSetsListAllFilteredWithContextFunc(context.Background(), zoneID, filter)
+	}
+	var r0 []vinyldns.RecordSet
+	return r0, notMocked("RecordSetsListAllFiltered")
+}
+
+// RecordSetsListAllFilteredWithContext records the call and invokes RecordSetsListAllFilteredWithContextFunc.
+func (m *Mock) RecordSetsListAllFilteredWithContext(ctx context.Context, zoneID string, filter vinyldns.ZoneRecordSetFilter) ([]vinyldns.RecordSet, error) {
+	m.record("RecordSetsListAllFilteredWithContext", ctx, zoneID, filter)
+	if m.RecordSetsListAllFilteredWithContextFunc != nil {
+		return m.RecordSetsListAllFilteredWithContextFunc(ctx, zoneID, filter)
+	}
+	var r0 []vinyldns.RecordSet
+	return r0, notMocked("RecordSetsListAllFilteredWithContext")
+}
+
+// RecordSetsPager records the call and invokes RecordSetsPagerFunc.
+func (m *Mock) RecordSetsPager(ctx context.Context, zoneID string, filter vinyldns.ListFilter) *vinyldns.Pager[vinyldns.RecordSet, string] {
+	m.record("RecordSetsPager", ctx, zoneID, filter)
+	if m.RecordSetsPagerFunc != nil {
+		return m.RecordSetsPagerFunc(ctx, zoneID, filter)
+	}
+	return notMockedPager[vinyldns.RecordSet, string]("RecordSetsPager")
+}
+
+// RecordSetsIter records the call and invokes RecordSetsIterFunc.
+func (m *Mock) RecordSetsIter(ctx context.Context, zoneID string, filter vinyldns.ListFilter) iter.Seq2[vinyldns.RecordSet, error] {
+	m.record("RecordSetsIter", ctx, zoneID, filter)
+	if m.RecordSetsIterFunc != nil {
+		return m.RecordSetsIterFunc(ctx, zoneID, filter)
+	}
+	return notMockedSeq[vinyldns.RecordSet]("RecordSetsIter")
+}
+
+// RecordSetsPagerFiltered records the call and invokes RecordSetsPagerFilteredFunc.
+func (m *Mock) RecordSetsPagerFiltered(ctx context.Context, zoneID string, filter vinyldns.ZoneRecordSetFilter) *vinyldns.Pager[vinyldns.RecordSet, string] {
+	m.record("RecordSetsPagerFiltered", ctx, zoneID, filter)
+	if m.RecordSetsPagerFilteredFunc != nil {
+		return m.RecordSetsPagerFilteredFunc(ctx, zoneID, filter)
+	}
+	return notMockedPager[vinyldns.RecordSet, string]("RecordSetsPagerFiltered")
+}
+
+// RecordSetsIterFiltered records the call and invokes RecordSetsIterFilteredFunc.
+func (m *Mock) RecordSetsIterFiltered(ctx context.Context, zoneID string, filter vinyldns.ZoneRecordSetFilter) iter.Seq2[vinyldns.RecordSet, error] {
+	m.record("RecordSetsIterFiltered", ctx, zoneID, filter)
+	if m.RecordSetsIterFilteredFunc != nil {
+		return m.RecordSetsIterFilteredFunc(ctx, zoneID, filter)
+	}
+	return notMockedSeq[vinyldns.RecordSet]("RecordSetsIterFiltered")
+}
+
+// RecordSetsGlobal records the call and invokes RecordSetsGlobalFunc.
+func (m *Mock) RecordSetsGlobal(filter vinyldns.GlobalListFilter) ([]vinyldns.RecordSet, string, error) {
+	m.record("RecordSetsGlobal", filter)
+	if m.RecordSetsGlobalFunc != nil {
+		return m.RecordSetsGlobalFunc(filter)
+	}
+	if m.RecordSetsGlobalWithContextFunc != nil {
+		return m.RecordSetsGlobalWithContextFunc(context.Background(), filter)
+	}
+	var r0 []vinyldns.RecordSet
+	var r1 string
+	return r0, r1, notMocked("RecordSetsGlobal")
+}
+
+// RecordSetsGlobalWithContext records the call and invokes RecordSetsGlobalWithContextFunc.
+func (m *Mock) RecordSetsGlobalWithContext(ctx context.Context, filter vinyldns.GlobalListFilter) ([]vinyldns.RecordSet, string, error) {
+	m.record("RecordSetsGlobalWithContext", ctx, filter)
+	if m.RecordSetsGlobalWithContextFunc != nil {
+		return m.RecordSetsGlobalWithContextFunc(ctx, filter)
+	}
+	var r0 []vinyldns.RecordSet
+	var r1 string
+	return r0, r1, notMocked("RecordSetsGlobalWithContext")
+}
+
+// RecordSetsGlobalListAll records the call and invokes RecordSetsGlobalListAllFunc.
+func (m *Mock) RecordSetsGlobalListAll(filter vinyldns.GlobalListFilter) ([]vinyldns.RecordSet, error) {
+	m.record("RecordSetsGlobalListAll", filter)
+	if m.RecordSetsGlobalListAllFunc != nil {
+		return m.RecordSetsGlobalListAllFunc(filter)
+	}
+	if m.RecordSetsGlobalListAllWithContextFunc != nil {
+		return m.RecordSetsGlobalListAllWithContextFunc(context.Background(), filter)
+	}
+	var r0 []vinyldns.RecordSet
+	return r0, notMocked("RecordSetsGlobalListAll")
+}
+
+// RecordSetsGlobalListAllWithContext records the call and invokes RecordSetsGlobalListAllWithContextFunc.
+func (m *Mock) RecordSetsGlobalListAllWithContext(ctx context.Context, filter vinyldns.GlobalListFilter) ([]vinyldns.RecordSet, error) {
+	m.record("RecordSetsGlobalListAllWithContext", ctx, filter)
+	if m.RecordSetsGlobalListAllWithContextFunc != nil {
+		return m.RecordSetsGlobalListAllWithContextFunc(ctx, filter)
+	}
+	var r0 []vinyldns.RecordSet
+	return r0, notMocked("RecordSetsGlobalListAllWithContext")
+}
+
+// RecordSetsGlobalPager records the call and invokes RecordSetsGlobalPagerFunc.
+func (m *Mock) RecordSetsGlobalPager(ctx context.Context, filter vinyldns.GlobalListFilter) *vinyldns.Pager[vinyldns.RecordSet, string] {
+	m.record("RecordSetsGlobalPager", ctx, filter)
+	if m.RecordSetsGlobalPagerFunc != nil {
+		return m.RecordSetsGlobalPagerFunc(ctx, filter)
+	}
+	return notMockedPager[vinyldns.RecordSet, string]("RecordSetsGlobalPager")
+}
+
+// RecordSetsGlobalIter records the call and invokes RecordSetsGlobalIterFunc.
+func (m *Mock) RecordSetsGlobalIter(ctx context.Context, filter vinyldns.GlobalListFilter) iter.Seq2[vinyldns.RecordSet, error] {
+	m.record("RecordSetsGlobalIter", ctx, filter)
+	if m.RecordSetsGlobalIterFunc != nil {
+		return m.RecordSetsGlobalIterFunc(ctx, filter)
+	}
+	return notMockedSeq[vinyldns.RecordSet]("RecordSetsGlobalIter")
+}
+
+// RecordSet records the call and invokes RecordSetFunc.
+func (m *Mock) RecordSet(zoneID string, recordSetID string) (vinyldns.RecordSet, error) {
+	m.record("RecordSet", zoneID, recordSetID)
+	if m.RecordSetFunc != nil {
+		return m.RecordSetFunc(zoneID, recordSetID)
+	}
+	if m.RecordSetWithContextFunc != nil {
+		return m.RecordSetWithContextFunc(context.Background(), zoneID, recordSetID)
+	}
+	var r0 vinyldns.RecordSet
+	return r0, notMocked("RecordSet")
+}
+
+// RecordSetWithContext records the call and invokes RecordSetWithContextFunc.
+func (m *Mock) RecordSetWithContext(ctx context.Context, zoneID string, recordSetID string) (vinyldns.RecordSet, error) {
+	m.record("RecordSetWithContext", ctx, zoneID, recordSetID)
+	if m.RecordSetWithContextFunc != nil {
+		return m.RecordSetWithContextFunc(ctx, zoneID, recordSetID)
+	}
+	var r0 vinyldns.RecordSet
+	return r0, notMocked("RecordSetWithContext")
+}
+
+// RecordSetCount records the call and invokes RecordSetCountFunc.
+func (m *Mock) RecordSetCount(zoneID string) (vinyldns.RecordSetCount, error) {
+	m.record("RecordSetCount", zoneID)
+	if m.RecordSetCountFunc != nil {
+		return m.RecordSetCountFunc(zoneID)
+	}
+	if m.RecordSetCountWithContextFunc != nil {
+		return m.RecordSetCountWithContextFunc(context.Background(), zoneID)
+	}
+	var r0 vinyldns.RecordSetCount
+	return r0, notMocked("RecordSetCount")
+}
+
+// RecordSetCountWithContext records the call and invokes RecordSetCountWithContextFunc.
+func (m *Mock) RecordSetCountWithContext(ctx context.Context, zoneID string) (vinyldns.RecordSetCount, error) {
+	m.record("RecordSetCountWithContext", ctx, zoneID)
+	if m.RecordSetCountWithContextFunc != nil {
+		return m.RecordSetCountWithContextFunc(ctx, zoneID)
+	}
+	var r0 vinyldns.RecordSetCount
+	return r0, notMocked("RecordSetCountWithContext")
+}
+
+// RecordSetCreate records the call and invokes RecordSetCreateFunc.
+func (m *Mock) RecordSetCreate(rs *vinyldns.RecordSet) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetCreate", rs)
+	if m.RecordSetCreateFunc != nil {
+		return m.RecordSetCreateFunc(rs)
+	}
+	if m.RecordSetCreateWithContextFunc != nil {
+		return m.RecordSetCreateWithContextFunc(context.Background(), rs)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetCreate")
+}
+
+// RecordSetCreateWithContext records the call and invokes RecordSetCreateWithContextFunc.
+func (m *Mock) RecordSetCreateWithContext(ctx context.Context, rs *vinyldns.RecordSet) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetCreateWithContext", ctx, rs)
+	if m.RecordSetCreateWithContextFunc != nil {
+		return m.RecordSetCreateWithContextFunc(ctx, rs)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetCreateWithContext")
+}
+
+// RecordSetUpdate records the call and invokes RecordSetUpdateFunc.
+func (m *Mock) RecordSetUpdate(rs *vinyldns.RecordSet) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetUpdate", rs)
+	if m.RecordSetUpdateFunc != nil {
+		return m.RecordSetUpdateFunc(rs)
+	}
+	if m.RecordSetUpdateWithContextFunc != nil {
+		return m.RecordSetUpdateWithContextFunc(context.Background(), rs)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetUpdate")
+}
+
+// RecordSetUpdateWithContext records the call and invokes RecordSetUpdateWithContextFunc.
+func (m *Mock) RecordSetUpdateWithContext(ctx context.Context, rs *vinyldns.RecordSet) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetUpdateWithContext", ctx, rs)
+	if m.RecordSetUpdateWithContextFunc != nil {
+		return m.RecordSetUpdateWithContextFunc(ctx, rs)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetUpdateWithContext")
+}
+
+// RecordSetOwnershipTransferRequest records the call and invokes RecordSetOwnershipTransferRequestFunc.
+func (m *Mock) RecordSetOwnershipTransferRequest(rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetOwnershipTransferRequest", rs, requestedOwnerGroupID)
+	if m.RecordSetOwnershipTransferRequestFunc != nil {
+		return m.RecordSetOwnershipTransferRequestFunc(rs, requestedOwnerGroupID)
+	}
+	if m.RecordSetOwnershipTransferRequestWithContextFunc != nil {
+		return m.RecordSetOwnershipTransferRequestWithContextFunc(context.Background(), rs, requestedOwnerGroupID)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetOwnershipTransferRequest")
+}
+
+// RecordSetOwnershipTransferRequestWithContext records the call and invokes RecordSetOwnershipTransferRequestWithContextFunc.
+func (m *Mock) RecordSetOwnershipTransferRequestWithContext(ctx context.Context, rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetOwnershipTransferRequestWithContext", ctx, rs, requestedOwnerGroupID)
+	if m.RecordSetOwnershipTransferRequestWithContextFunc != nil {
+		return m.RecordSetOwnershipTransferRequestWithContextFunc(ctx, rs, requestedOwnerGroupID)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetOwnershipTransferRequestWithContext")
+}
+
+// RecordSetOwnershipTransferApprove records the call and invokes RecordSetOwnershipTransferApproveFunc.
+func (m *Mock) RecordSetOwnershipTransferApprove(rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetOwnershipTransferApprove", rs, requestedOwnerGroupID)
+	if m.RecordSetOwnershipTransferApproveFunc != nil {
+		return m.RecordSetOwnershipTransferApproveFunc(rs, requestedOwnerGroupID)
+	}
+	if m.RecordSetOwnershipTransferApproveWithContextFunc != nil {
+		return m.RecordSetOwnershipTransferApproveWithContextFunc(context.Background(), rs, requestedOwnerGroupID)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetOwnershipTransferApprove")
+}
+
+// RecordSetOwnershipTransferApproveWithContext records the call and invokes RecordSetOwnershipTransferApproveWithContextFunc.
+func (m *Mock) RecordSetOwnershipTransferApproveWithContext(ctx context.Context, rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetOwnershipTransferApproveWithContext", ctx, rs, requestedOwnerGroupID)
+	if m.RecordSetOwnershipTransferApproveWithContextFunc != nil {
+		return m.RecordSetOwnershipTransferApproveWithContextFunc(ctx, rs, requestedOwnerGroupID)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetOwnershipTransferApproveWithContext")
+}
+
+// RecordSetOwnershipTransferReject records the call and invokes RecordSetOwnershipTransferRejectFunc.
+func (m *Mock) RecordSetOwnershipTransferReject(rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetOwnershipTransferReject", rs, requestedOwnerGroupID)
+	if m.RecordSetOwnershipTransferRejectFunc != nil {
+		return m.RecordSetOwnershipTransferRejectFunc(rs, requestedOwnerGroupID)
+	}
+	if m.RecordSetOwnershipTransferRejectWithContextFunc != nil {
+		return m.RecordSetOwnershipTransferRejectWithContextFunc(context.Background(), rs, requestedOwnerGroupID)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetOwnershipTransferReject")
+}
+
+// RecordSetOwnershipTransferRejectWithContext records the call and invokes RecordSetOwnershipTransferRejectWithContextFunc.
+func (m *Mock) RecordSetOwnershipTransferRejectWithContext(ctx context.Context, rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetOwnershipTransferRejectWithContext", ctx, rs, requestedOwnerGroupID)
+	if m.RecordSetOwnershipTransferRejectWithContextFunc != nil {
+		return m.RecordSetOwnershipTransferRejectWithContextFunc(ctx, rs, requestedOwnerGroupID)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetOwnershipTransferRejectWithContext")
+}
+
+// RecordSetOwnershipTransferCancel records the call and invokes RecordSetOwnershipTransferCancelFunc.
+func (m *Mock) RecordSetOwnershipTransferCancel(rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetOwnershipTransferCancel", rs, requestedOwnerGroupID)
+	if m.RecordSetOwnershipTransferCancelFunc != nil {
+		return m.RecordSetOwnershipTransferCancelFunc(rs, requestedOwnerGroupID)
+	}
+	if m.RecordSetOwnershipTransferCancelWithContextFunc != nil {
+		return m.RecordSetOwnershipTransferCancelWithContextFunc(context.Background(), rs, requestedOwnerGroupID)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetOwnershipTransferCancel")
+}
+
+// RecordSetOwnershipTransferCancelWithContext records the call and invokes RecordSetOwnershipTransferCancelWithContextFunc.
+func (m *Mock) RecordSetOwnershipTransferCancelWithContext(ctx context.Context, rs *vinyldns.RecordSet, requestedOwnerGroupID string) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetOwnershipTransferCancelWithContext", ctx, rs, requestedOwnerGroupID)
+	if m.RecordSetOwnershipTransferCancelWithContextFunc != nil {
+		return m.RecordSetOwnershipTransferCancelWithContextFunc(ctx, rs, requestedOwnerGroupID)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetOwnershipTransferCancelWithContext")
+}
+
+// RecordSetDelete records the call and invokes RecordSetDeleteFunc.
+func (m *Mock) RecordSetDelete(zoneID string, recordSetID string) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetDelete", zoneID, recordSetID)
+	if m.RecordSetDeleteFunc != nil {
+		return m.RecordSetDeleteFunc(zoneID, recordSetID)
+	}
+	if m.RecordSetDeleteWithContextFunc != nil {
+		return m.RecordSetDeleteWithContextFunc(context.Background(), zoneID, recordSetID)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetDelete")
+}
+
+// RecordSetDeleteWithContext records the call and invokes RecordSetDeleteWithContextFunc.
+func (m *Mock) RecordSetDeleteWithContext(ctx context.Context, zoneID string, recordSetID string) (*vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetDeleteWithContext", ctx, zoneID, recordSetID)
+	if m.RecordSetDeleteWithContextFunc != nil {
+		return m.RecordSetDeleteWithContextFunc(ctx, zoneID, recordSetID)
+	}
+	var r0 *vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetDeleteWithContext")
+}
+
+// RecordSetChanges records the call and invokes RecordSetChangesFunc.
+func (m *Mock) RecordSetChanges(zoneID string, f vinyldns.ListFilterRecordSetChanges) (*vinyldns.RecordSetChanges, error) {
+	m.record("RecordSetChanges", zoneID, f)
+	if m.RecordSetChangesFunc != nil {
+		return m.RecordSetChangesFunc(zoneID, f)
+	}
+	if m.RecordSetChangesWithContextFunc != nil {
+		return m.RecordSetChangesWithContextFunc(context.Background(), zoneID, f)
+	}
+	var r0 *vinyldns.RecordSetChanges
+	return r0, notMocked("RecordSetChanges")
+}
+
+// RecordSetChangesWithContext records the call and invokes RecordSetChangesWithContextFunc.
+func (m *Mock) RecordSetChangesWithContext(ctx context.Context, zoneID string, f vinyldns.ListFilterRecordSetChanges) (*vinyldns.RecordSetChanges, error) {
+	m.record("RecordSetChangesWithContext", ctx, zoneID, f)
+	if m.RecordSetChangesWithContextFunc != nil {
+		return m.RecordSetChangesWithContextFunc(ctx, zoneID, f)
+	}
+	var r0 *vinyldns.RecordSetChanges
+	return r0, notMocked("RecordSetChangesWithContext")
+}
+
+// RecordSetChangeHistory records the call and invokes RecordSetChangeHistoryFunc.
+func (m *Mock) RecordSetChangeHistory(f vinyldns.RecordSetChangeHistoryFilter) (*vinyldns.RecordSetChanges, error) {
+	m.record("RecordSetChangeHistory", f)
+	if m.RecordSetChangeHistoryFunc != nil {
+		return m.RecordSetChangeHistoryFunc(f)
+	}
+	if m.RecordSetChangeHistoryWithContextFunc != nil {
+		return m.RecordSetChangeHistoryWithContextFunc(context.Background(), f)
+	}
+	var r0 *vinyldns.RecordSetChanges
+	return r0, notMocked("RecordSetChangeHistory")
+}
+
+// RecordSetChangeHistoryWithContext records the call and invokes RecordSetChangeHistoryWithContextFunc.
+func (m *Mock) RecordSetChangeHistoryWithContext(ctx context.Context, f vinyldns.RecordSetChangeHistoryFilter) (*vinyldns.RecordSetChanges, error) {
+	m.record("RecordSetChangeHistoryWithContext", ctx, f)
+	if m.RecordSetChangeHistoryWithContextFunc != nil {
+		return m.RecordSetChangeHistoryWithContextFunc(ctx, f)
+	}
+	var r0 *vinyldns.RecordSetChanges
+	return r0, notMocked("RecordSetChangeHistoryWithContext")
+}
+
+// RecordSetChangesListAll records the call and invokes RecordSetChangesListAllFunc.
+func (m *Mock) RecordSetChangesListAll(zoneID string, filter vinyldns.ListFilterRecordSetChanges) ([]vinyldns.RecordSetChange, error) {
+	m.record("RecordSetChangesListAll", zoneID, filter)
+	if m.RecordSetChangesListAllFunc != nil {
+		return m.RecordSetChangesListAllFunc(zoneID, filter)
+	}
+	if m.RecordSetChangesListAllWithContextFunc != nil {
+		return m.RecordSetChangesListAllWithContextFunc(context.Background(), zoneID, filter)
+	}
+	var r0 []vinyldns.RecordSetChange
+	return r0, notMocked("RecordSetChangesListAll")
+}
+
+// RecordSetChangesListAllWithContext records the call and invokes RecordSetChangesListAllWithContextFunc.
+func (m *Mock) RecordSetChangesListAllWithContext(ctx context.Context, zoneID string, filter vinyldns.ListFilterRecordSetChanges) ([]vinyldns.RecordSetChange, error) {
+	m.record("RecordSetChangesListAllWithContext", ctx, zoneID, filter)
+	if m.RecordSetChangesListAllWithContextFunc != nil {
+		return m.RecordSetChangesListAllWithContextFunc(ctx, zoneID, filter)
+	}
+	var r0 []vinyldns.RecordSetChange
+	return r0, notMocked("RecordSetChangesListAllWithContext")
+}
+
+// RecordSetChangesPager records the call and invokes RecordSetChangesPagerFunc.
+func (m *Mock) RecordSetChangesPager(ctx context.Context, zoneID string, filter vinyldns.ListFilterRecordSetChanges) *vinyldns.Pager[vinyldns.RecordSetChange, int] {
+	m.record("RecordSetChangesPager", ctx, zoneID, filter)
+	if m.RecordSetChangesPagerFunc != nil {
+		return m.RecordSetChangesPagerFunc(ctx, zoneID, filter)
+	}
+	return notMockedPager[vinyldns.RecordSetChange, int]("RecordSetChangesPager")
+}
+
+// RecordSetChangesIter records the call and invokes RecordSetChangesIterFunc.
+func (m *Mock) RecordSetChangesIter(ctx context.Context, zoneID string, filter vinyldns.ListFilterRecordSetChanges) iter.Seq2[vinyldns.RecordSetChange, error] {
+	m.record("RecordSetChangesIter", ctx, zoneID, filter)
+	if m.RecordSetChangesIterFunc != nil {
+		return m.RecordSetChangesIterFunc(ctx, zoneID, filter)
+	}
+	return notMockedSeq[vinyldns.RecordSetChange]("RecordSetChangesIter")
+}
+
+// RecordSetChange records the call and invokes RecordSetChangeFunc.
+func (m *Mock) RecordSetChange(zoneID string, recordSetID string, changeID string) (*vinyldns.RecordSetChange, error) {
+	m.record("RecordSetChange", zoneID, recordSetID, changeID)
+	if m.RecordSetChangeFunc != nil {
+		return m.RecordSetChangeFunc(zoneID, recordSetID, changeID)
+	}
+	if m.RecordSetChangeWithContextFunc != nil {
+		return m.RecordSetChangeWithContextFunc(context.Background(), zoneID, recordSetID, changeID)
+	}
+	var r0 *vinyldns.RecordSetChange
+	return r0, notMocked("RecordSetChange")
+}
+
+// RecordSetChangeWithContext records the call and invokes RecordSetChangeWithContextFunc.
+func (m *Mock) RecordSetChangeWithContext(ctx context.Context, zoneID string, recordSetID string, changeID string) (*vinyldns.RecordSetChange, error) {
+	m.record("RecordSetChangeWithContext", ctx, zoneID, recordSetID, changeID)
+	if m.RecordSetChangeWithContextFunc != nil {
+		return m.RecordSetChangeWithContextFunc(ctx, zoneID, recordSetID, changeID)
+	}
+	var r0 *vinyldns.RecordSetChange
+	return r0, notMocked("RecordSetChangeWithContext")
+}
+
+// RecordSetChangesFailure records the call and invokes RecordSetChangesFailureFunc.
+func (m *Mock) RecordSetChangesFailure(zoneID string, filter vinyldns.ListFilter) (*vinyldns.RecordSetChangeFailuresResponse, error) {
+	m.record("RecordSetChangesFailure", zoneID, filter)
+	if m.RecordSetChangesFailureFunc != nil {
+		return m.RecordSetChangesFailureFunc(zoneID, filter)
+	}
+	if m.RecordSetChangesFailureWithContextFunc != nil {
+		return m.RecordSetChangesFailureWithContextFunc(context.Background(), zoneID, filter)
+	}
+	var r0 *vinyldns.RecordSetChangeFailuresResponse
+	return r0, notMocked("RecordSetChangesFailure")
+}
+
+// RecordSetChangesFailureWithContext records the call and invokes RecordSetChangesFailureWithContextFunc.
+func (m *Mock) RecordSetChangesFailureWithContext(ctx context.Context, zoneID string, filter vinyldns.ListFilter) (*vinyldns.RecordSetChangeFailuresResponse, error) {
+	m.record("RecordSetChangesFailureWithContext", ctx, zoneID, filter)
+	if m.RecordSetChangesFailureWithContextFunc != nil {
+		return m.RecordSetChangesFailureWithContextFunc(ctx, zoneID, filter)
+	}
+	var r0 *vinyldns.RecordSetChangeFailuresResponse
+	return r0, notMocked("RecordSetChangesFailureWithContext")
+}
+
+// RecordSetsImport records the call and invokes RecordSetsImportFunc.
+func (m *Mock) RecordSetsImport(zoneID string, recordSets []vinyldns.RecordSet) (*vinyldns.RecordSetsImportResult, error) {
+	m.record("RecordSetsImport", zoneID, recordSets)
+	if m.RecordSetsImportFunc != nil {
+		return m.RecordSetsImportFunc(zoneID, recordSets)
+	}
+	if m.RecordSetsImportWithContextFunc != nil {
+		return m.RecordSetsImportWithContextFunc(context.Background(), zoneID, recordSets)
+	}
+	var r0 *vinyldns.RecordSetsImportResult
+	return r0, notMocked("RecordSetsImport")
+}
+
+// RecordSetsImportWithContext records the call and invokes RecordSetsImportWithContextFunc.
+func (m *Mock) RecordSetsImportWithContext(ctx context.Context, zoneID string, recordSets []vinyldns.RecordSet) (*vinyldns.RecordSetsImportResult, error) {
+	m.record("RecordSetsImportWithContext", ctx, zoneID, recordSets)
+	if m.RecordSetsImportWithContextFunc != nil {
+		return m.RecordSetsImportWithContextFunc(ctx, zoneID, recordSets)
+	}
+	var r0 *vinyldns.RecordSetsImportResult
+	return r0, notMocked("RecordSetsImportWithContext")
+}
+
+// RecordSetsPlan records the call and invokes RecordSetsPlanFunc.
+func (m *Mock) RecordSetsPlan(zoneID string, desired []vinyldns.RecordSet, opts vinyldns.PlanOptions) (*vinyldns.Plan, error) {
+	m.record("RecordSetsPlan", zoneID, desired, opts)
+	if m.RecordSetsPlanFunc != nil {
+		return m.RecordSetsPlanFunc(zoneID, desired, opts)
+	}
+	if m.RecordSetsPlanWithContextFunc != nil {
+		return m.RecordSetsPlanWithContextFunc(context.Background(), zoneID, desired, opts)
+	}
+	var r0 *vinyldns.Plan
+	return r0, notMocked("RecordSetsPlan")
+}
+
+// RecordSetsPlanWithContext records the call and invokes RecordSetsPlanWithContextFunc.
+func (m *Mock) RecordSetsPlanWithContext(ctx context.Context, zoneID string, desired []vinyldns.RecordSet, opts vinyldns.PlanOptions) (*vinyldns.Plan, error) {
+	m.record("RecordSetsPlanWithContext", ctx, zoneID, desired, opts)
+	if m.RecordSetsPlanWithContextFunc != nil {
+		return m.RecordSetsPlanWithContextFunc(ctx, zoneID, desired, opts)
+	}
+	var r0 *vinyldns.Plan
+	return r0, notMocked("RecordSetsPlanWithContext")
+}
+
+// RecordSetsApply records the call and invokes RecordSetsApplyFunc.
+func (m *Mock) RecordSetsApply(plan *vinyldns.Plan, opts *vinyldns.WaitOptions) ([]vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetsApply", plan, opts)
+	if m.RecordSetsApplyFunc != nil {
+		return m.RecordSetsApplyFunc(plan, opts)
+	}
+	if m.RecordSetsApplyWithContextFunc != nil {
+		return m.RecordSetsApplyWithContextFunc(context.Background(), plan, opts)
+	}
+	var r0 []vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetsApply")
+}
+
+// RecordSetsApplyWithContext records the call and invokes RecordSetsApplyWithContextFunc.
+func (m *Mock) RecordSetsApplyWithContext(ctx context.Context, plan *vinyldns.Plan, opts *vinyldns.WaitOptions) ([]vinyldns.RecordSetUpdateResponse, error) {
+	m.record("RecordSetsApplyWithContext", ctx, plan, opts)
+	if m.RecordSetsApplyWithContextFunc != nil {
+		return m.RecordSetsApplyWithContextFunc(ctx, plan, opts)
+	}
+	var r0 []vinyldns.RecordSetUpdateResponse
+	return r0, notMocked("RecordSetsApplyWithContext")
+}
+
+// WaitForRecordSetChange records the call and invokes WaitForRecordSetChangeFunc.
+func (m *Mock) WaitForRecordSetChange(ctx context.Context, zoneID string, recordSetID string, changeID string, opts *vinyldns.WaitOptions) (*vinyldns.RecordSetChange, error) {
+	m.record("WaitForRecordSetChange", ctx, zoneID, recordSetID, changeID, opts)
+	if m.WaitForRecordSetChangeFunc != nil {
+		return m.WaitForRecordSetChangeFunc(ctx, zoneID, recordSetID, changeID, opts)
+	}
+	var r0 *vinyldns.RecordSetChange
+	return r0, notMocked("WaitForRecordSetChange")
+}
+
+// Groups records the call and invokes GroupsFunc.
+func (m *Mock) Groups() ([]vinyldns.Group, error) {
+	m.record("Groups")
+	if m.GroupsFunc != nil {
+		return m.GroupsFunc()
+	}
+	if m.GroupsWithContextFunc != nil {
+		return m.GroupsWithContextFunc(context.Background())
+	}
+	var r0 []vinyldns.Group
+	return r0, notMocked("Groups")
+}
+
+// GroupsWithContext records the call and invokes GroupsWithContextFunc.
+func (m *Mock) GroupsWithContext(ctx context.Context) ([]vinyldns.Group, error) {
+	m.record("GroupsWithContext", ctx)
+	if m.GroupsWithContextFunc != nil {
+		return m.GroupsWithContextFunc(ctx)
+	}
+	var r0 []vinyldns.Group
+	return r0, notMocked("GroupsWithContext")
+}
+
+// GroupsListAll records the call and invokes GroupsListAllFunc.
+func (m *Mock) GroupsListAll(filter vinyldns.ListFilter) ([]vinyldns.Group, error) {
+	m.record("GroupsListAll", filter)
+	if m.GroupsListAllFunc != nil {
+		return m.GroupsListAllFunc(filter)
+	}
+	if m.GroupsListAllWithContextFunc != nil {
+		return m.GroupsListAllWithContextFunc(context.Background(), filter)
+	}
+	var r0 []vinyldns.Group
+	return r0, notMocked("GroupsListAll")
+}
+
+// GroupsListAllWithContext records the call and invokes GroupsListAllWithContextFunc.
+func (m *Mock) GroupsListAllWithContext(ctx context.Context, filter vinyldns.ListFilter) ([]vinyldns.Group, error) {
+	m.record("GroupsListAllWithContext", ctx, filter)
+	if m.GroupsListAllWithContextFunc != nil {
+		return m.GroupsListAllWithContextFunc(ctx, filter)
+	}
+	var r0 []vinyldns.Group
+	return r0, notMocked("GroupsListAllWithContext")
+}
+
+// GroupsPager records the call and invokes GroupsPagerFunc.
+func (m *Mock) GroupsPager(ctx context.Context, filter vinyldns.ListFilter) *vinyldns.Pager[vinyldns.Group, string] {
+	m.record("GroupsPager", ctx, filter)
+	if m.GroupsPagerFunc != nil {
+		return m.GroupsPagerFunc(ctx, filter)
+	}
+	return notMockedPager[vinyldns.Group, string]("GroupsPager")
+}
+
+// GroupsIter records the call and invokes GroupsIterFunc.
+func (m *Mock) GroupsIter(ctx context.Context, filter vinyldns.ListFilter) iter.Seq2[vinyldns.Group, error] {
+	m.record("GroupsIter", ctx, filter)
+	if m.GroupsIterFunc != nil {
+		return m.GroupsIterFunc(ctx, filter)
+	}
+	return notMockedSeq[vinyldns.Group]("GroupsIter")
+}
+
+// GroupCreate records the call and invokes GroupCreateFunc.
+func (m *Mock) GroupCreate(g *vinyldns.Group) (*vinyldns.Group, error) {
+	m.record("GroupCreate", g)
+	if m.GroupCreateFunc != nil {
+		return m.GroupCreateFunc(g)
+	}
+	if m.GroupCreateWithContextFunc != nil {
+		return m.GroupCreateWithContextFunc(context.Background(), g)
+	}
+	var r0 *vinyldns.Group
+	return r0, notMocked("GroupCreate")
+}
+
+// GroupCreateWithContext records the call and invokes GroupCreateWithContextFunc.
+func (m *Mock) GroupCreateWithContext(ctx context.Context, g *vinyldns.Group) (*vinyldns.Group, error) {
+	m.record("GroupCreateWithContext", ctx, g)
+	if m.GroupCreateWithContextFunc != nil {
+		return m.GroupCreateWithContextFunc(ctx, g)
+	}
+	var r0 *vinyldns.Group
+	return r0, notMocked("GroupCreateWithContext")
+}
+
+// Group records the call and invokes GroupFunc.
+func (m *Mock) Group(groupID string) (*vinyldns.Group, error) {
+	m.record("Group", groupID)
+	if m.GroupFunc != nil {
+		return m.GroupFunc(groupID)
+	}
+	if m.GroupWithContextFunc != nil {
+		return m.GroupWithContextFunc(context.Background(), groupID)
+	}
+	var r0 *vinyldns.Group
+	return r0, notMocked("Group")
+}
+
+// GroupWithContext records the call and invokes GroupWithContextFunc.
+func (m *Mock) GroupWithContext(ctx context.Context, groupID string) (*vinyldns.Group, error) {
+	m.record("GroupWithContext", ctx, groupID)
+	if m.GroupWithContextFunc != nil {
+		return m.GroupWithContextFunc(ctx, groupID)
+	}
+	var r0 *vinyldns.Group
+	return r0, notMocked("GroupWithContext")
+}
+
+// GroupDelete records the call and invokes GroupDeleteFunc.
+func (m *Mock) GroupDelete(groupID string) (*vinyldns.Group, error) {
+	m.record("GroupDelete", groupID)
+	if m.GroupDeleteFunc != nil {
+		return m.GroupDeleteFunc(groupID)
+	}
+	if m.GroupDeleteWithContextFunc != nil {
+		return m.GroupDeleteWithContextFunc(context.Background(), groupID)
+	}
+	var r0 *vinyldns.Group
+	return r0, notMocked("GroupDelete")
+}
+
+// GroupDeleteWithContext records the call and invokes GroupDeleteWithContextFunc.
+func (m *Mock) GroupDeleteWithContext(ctx context.Context, groupID string) (*vinyldns.Group, error) {
+	m.record("GroupDeleteWithContext", ctx, groupID)
+	if m.GroupDeleteWithContextFunc != nil {
+		return m.GroupDeleteWithContextFunc(ctx, groupID)
+	}
+	var r0 *vinyldns.Group
+	return r0, notMocked("GroupDeleteWithContext")
+}
+
+// GroupUpdate records the call and invokes GroupUpdateFunc.
+func (m *Mock) GroupUpdate(groupID string, g *vinyldns.Group) (*vinyldns.Group, error) {
+	m.record("GroupUpdate", groupID, g)
+	if m.GroupUpdateFunc != nil {
+		return m.GroupUpdateFunc(groupID, g)
+	}
+	if m.GroupUpdateWithContextFunc != nil {
+		return m.GroupUpdateWithContextFunc(context.Background(), groupID, g)
+	}
+	var r0 *vinyldns.Group
+	return r0, notMocked("GroupUpdate")
+}
+
+// GroupUpdateWithContext records the call and invokes GroupUpdateWithContextFunc.
+func (m *Mock) GroupUpdateWithContext(ctx context.Context, groupID string, g *vinyldns.Group) (*vinyldns.Group, error) {
+	m.record("GroupUpdateWithContext", ctx, groupID, g)
+	if m.GroupUpdateWithContextFunc != nil {
+		return m.GroupUpdateWithContextFunc(ctx, groupID, g)
+	}
+	var r0 *vinyldns.Group
+	return r0, notMocked("GroupUpdateWithContext")
+}
+
+// GroupAdmins records the call and invokes GroupAdminsFunc.
+func (m *Mock) GroupAdmins(groupID string) ([]vinyldns.User, error) {
+	m.record("GroupAdmins", groupID)
+	if m.GroupAdminsFunc != nil {
+		return m.GroupAdminsFunc(groupID)
+	}
+	if m.GroupAdminsWithContextFunc != nil {
+		return m.GroupAdminsWithContextFunc(context.Background(), groupID)
+	}
+	var r0 []vinyldns.User
+	return r0, notMocked("GroupAdmins")
+}
+
+// GroupAdminsWithContext records the call and invokes GroupAdminsWithContextFunc.
+func (m *Mock) GroupAdminsWithContext(ctx context.Context, groupID string) ([]vinyldns.User, error) {
+	m.record("GroupAdminsWithContext", ctx, groupID)
+	if m.GroupAdminsWithContextFunc != nil {
+		return m.GroupAdminsWithContextFunc(ctx, groupID)
+	}
+	var r0 []vinyldns.User
+	return r0, notMocked("GroupAdminsWithContext")
+}
+
+// GroupMembers records the call and invokes GroupMembersFunc.
+func (m *Mock) GroupMembers(groupID string) ([]vinyldns.User, error) {
+	m.record("GroupMembers", groupID)
+	if m.GroupMembersFunc != nil {
+		return m.GroupMembersFunc(groupID)
+	}
+	if m.GroupMembersWithContextFunc != nil {
+		return m.GroupMembersWithContextFunc(context.Background(), groupID)
+	}
+	var r0 []vinyldns.User
+	return r0, notMocked("GroupMembers")
+}
+
+// GroupMembersWithContext records the call and invokes GroupMembersWithContextFunc.
+func (m *Mock) GroupMembersWithContext(ctx context.Context, groupID string) ([]vinyldns.User, error) {
+	m.record("GroupMembersWithContext", ctx, groupID)
+	if m.GroupMembersWithContextFunc != nil {
+		return m.GroupMembersWithContextFunc(ctx, groupID)
+	}
+	var r0 []vinyldns.User
+	return r0, notMocked("GroupMembersWithContext")
+}
+
+// GroupActivity records the call and invokes GroupActivityFunc.
+func (m *Mock) GroupActivity(groupID string) (*vinyldns.GroupChanges, error) {
+	m.record("GroupActivity", groupID)
+	if m.GroupActivityFunc != nil {
+		return m.GroupActivityFunc(groupID)
+	}
+	if m.GroupActivityWithContextFunc != nil {
+		return m.GroupActivityWithContextFunc(context.Background(), groupID)
+	}
+	var r0 *vinyldns.GroupChanges
+	return r0, notMocked("GroupActivity")
+}
+
+// GroupActivityWithContext records the call and invokes GroupActivityWithContextFunc.
+func (m *Mock) GroupActivityWithContext(ctx context.Context, groupID string) (*vinyldns.GroupChanges, error) {
+	m.record("GroupActivityWithContext", ctx, groupID)
+	if m.GroupActivityWithContextFunc != nil {
+		return m.GroupActivityWithContextFunc(ctx, groupID)
+	}
+	var r0 *vinyldns.GroupChanges
+	return r0, notMocked("GroupActivityWithContext")
+}
+
+// GroupChange records the call and invokes GroupChangeFunc.
+func (m *Mock) GroupChange(groupChangeID string) (*vinyldns.GroupChange, error) {
+	m.record("GroupChange", groupChangeID)
+	if m.GroupChangeFunc != nil {
+		return m.GroupChangeFunc(groupChangeID)
+	}
+	if m.GroupChangeWithContextFunc != nil {
+		return m.GroupChangeWithContextFunc(context.Background(), groupChangeID)
+	}
+	var r0 *vinyldns.GroupChange
+	return r0, notMocked("GroupChange")
+}
+
+// GroupChangeWithContext records the call and invokes GroupChangeWithContextFunc.
+func (m *Mock) GroupChangeWithContext(ctx context.Context, groupChangeID string) (*vinyldns.GroupChange, error) {
+	m.record("GroupChangeWithContext", ctx, groupChangeID)
+	if m.GroupChangeWithContextFunc != nil {
+		return m.GroupChangeWithContextFunc(ctx, groupChangeID)
+	}
+	var r0 *vinyldns.GroupChange
+	return r0, notMocked("GroupChangeWithContext")
+}
+
+// GroupValidDomains records the call and invokes GroupValidDomainsFunc.
+func (m *Mock) GroupValidDomains() ([]string, error) {
+	m.record("GroupValidDomains")
+	if m.GroupValidDomainsFunc != nil {
+		return m.GroupValidDomainsFunc()
+	}
+	if m.GroupValidDomainsWithContextFunc != nil {
+		return m.GroupValidDomainsWithContextFunc(context.Background())
+	}
+	var r0 []string
+	return r0, notMocked("GroupValidDomains")
+}
+
+// GroupValidDomainsWithContext records the call and invokes GroupValidDomainsWithContextFunc.
+func (m *Mock) GroupValidDomainsWithContext(ctx context.Context) ([]string, error) {
+	m.record("GroupValidDomainsWithContext", ctx)
+	if m.GroupValidDomainsWithContextFunc != nil {
+		return m.GroupValidDomainsWithContextFunc(ctx)
+	}
+	var r0 []string
+	return r0, notMocked("GroupValidDomainsWithContext")
+}
+
+// BatchRecordChanges records the call and invokes BatchRecordChangesFunc.
+func (m *Mock) BatchRecordChanges() ([]vinyldns.RecordChange, error) {
+	m.record("BatchRecordChanges")
+	if m.BatchRecordChangesFunc != nil {
+		return m.BatchRecordChangesFunc()
+	}
+	if m.BatchRecordChangesWithContextFunc != nil {
+		return m.BatchRecordChangesWithContextFunc(context.Background())
+	}
+	var r0 []vinyldns.RecordChange
+	return r0, notMocked("BatchRecordChanges")
+}
+
+// BatchRecordChangesWithContext records the call and invokes BatchRecordChangesWithContextFunc.
+func (m *Mock) BatchRecordChangesWithContext(ctx context.Context) ([]vinyldns.RecordChange, error) {
+	m.record("BatchRecordChangesWithContext", ctx)
+	if m.BatchRecordChangesWithContextFunc != nil {
+		return m.BatchRecordChangesWithContextFunc(ctx)
+	}
+	var r0 []vinyldns.RecordChange
+	return r0, notMocked("BatchRecordChangesWithContext")
+}
+
+// BatchRecordChangesList records the call and invokes BatchRecordChangesListFunc.
+func (m *Mock) BatchRecordChangesList(filter vinyldns.BatchRecordChangesFilter) (*vinyldns.BatchChangeSummaries, error) {
+	m.record("BatchRecordChangesList", filter)
+	if m.BatchRecordChangesListFunc != nil {
+		return m.BatchRecordChangesListFunc(filter)
+	}
+	if m.BatchRecordChangesListWithContextFunc != nil {
+		return m.BatchRecordChangesListWithContextFunc(context.Background(), filter)
+	}
+	var r0 *vinyldns.BatchChangeSummaries
+	return r0, notMocked("BatchRecordChangesList")
+}
+
+// BatchRecordChangesListWithContext records the call and invokes BatchRecordChangesListWithContextFunc.
+func (m *Mock) BatchRecordChangesListWithContext(ctx context.Context, filter vinyldns.BatchRecordChangesFilter) (*vinyldns.BatchChangeSummaries, error) {
+	m.record("BatchRecordChangesListWithContext", ctx, filter)
+	if m.BatchRecordChangesListWithContextFunc != nil {
+		return m.BatchRecordChangesListWithContextFunc(ctx, filter)
+	}
+	var r0 *vinyldns.BatchChangeSummaries
+	return r0, notMocked("BatchRecordChangesListWithContext")
+}
+
+// BatchRecordChangesListAll records the call and invokes BatchRecordChangesListAllFunc.
+func (m *Mock) BatchRecordChangesListAll(filter vinyldns.BatchRecordChangesFilter) ([]vinyldns.BatchChangeSummary, error) {
+	m.record("BatchRecordChangesListAll", filter)
+	if m.BatchRecordChangesListAllFunc != nil {
+		return m.BatchRecordChangesListAllFunc(filter)
+	}
+	if m.BatchRecordChangesListAllWithContextFunc != nil {
+		return m.BatchRecordChangesListAllWithContextFunc(context.Background(), filter)
+	}
+	var r0 []vinyldns.BatchChangeSummary
+	return r0, notMocked("BatchRecordChangesListAll")
+}
+
+// BatchRecordChangesListAllWithContext records the call and invokes BatchRecordChangesListAllWithContextFunc.
+func (m *Mock) BatchRecordChangesListAllWithContext(ctx context.Context, filter vinyldns.BatchRecordChangesFilter) ([]vinyldns.BatchChangeSummary, error) {
+	m.record("BatchRecordChangesListAllWithContext", ctx, filter)
+	if m.BatchRecordChangesListAllWithContextFunc != nil {
+		return m.BatchRecordChangesListAllWithContextFunc(ctx, filter)
+	}
+	var r0 []vinyldns.BatchChangeSummary
+	return r0, notMocked("BatchRecordChangesListAllWithContext")
+}
+
+// BatchRecordChangesPager records the call and invokes BatchRecordChangesPagerFunc.
+func (m *Mock) BatchRecordChangesPager(ctx context.Context, filter vinyldns.BatchRecordChangesFilter) *vinyldns.Pager[vinyldns.BatchChangeSummary, int] {
+	m.record("BatchRecordChangesPager", ctx, filter)
+	if m.BatchRecordChangesPagerFunc != nil {
+		return m.BatchRecordChangesPagerFunc(ctx, filter)
+	}
+	return notMockedPager[vinyldns.BatchChangeSummary, int]("BatchRecordChangesPager")
+}
+
+// BatchRecordChangesIter records the call and invokes BatchRecordChangesIterFunc.
+func (m *Mock) BatchRecordChangesIter(ctx context.Context, filter vinyldns.BatchRecordChangesFilter) iter.Seq2[vinyldns.BatchChangeSummary, error] {
+	m.record("BatchRecordChangesIter", ctx, filter)
+	if m.BatchRecordChangesIterFunc != nil {
+		return m.BatchRecordChangesIterFunc(ctx, filter)
+	}
+	return notMockedSeq[vinyldns.BatchChangeSummary]("BatchRecordChangesIter")
+}
+
+// BatchRecordChangesScheduled records the call and invokes BatchRecordChangesScheduledFunc.
+func (m *Mock) BatchRecordChangesScheduled(filter vinyldns.BatchRecordChangesFilter) ([]vinyldns.BatchChangeSummary, error) {
+	m.record("BatchRecordChangesScheduled", filter)
+	if m.BatchRecordChangesScheduledFunc != nil {
+		return m.BatchRecordChangesScheduledFunc(filter)
+	}
+	if m.BatchRecordChangesScheduledWithContextFunc != nil {
+		return m.BatchRecordChangesScheduledWithContextFunc(context.Background(), filter)
+	}
+	var r0 []vinyldns.BatchChangeSummary
+	return r0, notMocked("BatchRecordChangesScheduled")
+}
+
+// BatchRecordChangesScheduledWithContext records the call and invokes BatchRecordChangesScheduledWithContextFunc.
+func (m *Mock) BatchRecordChangesScheduledWithContext(ctx context.Context, filter vinyldns.BatchRecordChangesFilter) ([]vinyldns.BatchChangeSummary, error) {
+	m.record("BatchRecordChangesScheduledWithContext", ctx, filter)
+	if m.BatchRecordChangesScheduledWithContextFunc != nil {
+		return m.BatchRecordChangesScheduledWithContextFunc(ctx, filter)
+	}
+	var r0 []vinyldns.BatchChangeSummary
+	return r0, notMocked("BatchRecordChangesScheduledWithContext")
+}
+
+// BatchRecordChange records the call and invokes BatchRecordChangeFunc.
+func (m *Mock) BatchRecordChange(changeID string) (*vinyldns.BatchRecordChange, error) {
+	m.record("BatchRecordChange", changeID)
+	if m.BatchRecordChangeFunc != nil {
+		return m.BatchRecordChangeFunc(changeID)
+	}
+	if m.BatchRecordChangeWithContextFunc != nil {
+		return m.BatchRecordChangeWithContextFunc(context.Background(), changeID)
+	}
+	var r0 *vinyldns.BatchRecordChange
+	return r0, notMocked("BatchRecordChange")
+}
+
+// BatchRecordChangeWithContext records the call and invokes BatchRecordChangeWithContextFunc.
+func (m *Mock) BatchRecordChangeWithContext(ctx context.Context, changeID string) (*vinyldns.BatchRecordChange, error) {
+	m.record("BatchRecordChangeWithContext", ctx, changeID)
+	if m.BatchRecordChangeWithContextFunc != nil {
+		return m.BatchRecordChangeWithContextFunc(ctx, changeID)
+	}
+	var r0 *vinyldns.BatchRecordChange
+	return r0, notMocked("BatchRecordChangeWithContext")
+}
+
+// BatchRecordChangeCreate records the call and invokes BatchRecordChangeCreateFunc.
+func (m *Mock) BatchRecordChangeCreate(change *vinyldns.BatchRecordChange) (*vinyldns.BatchRecordChangeUpdateResponse, error) {
+	m.record("BatchRecordChangeCreate", change)
+	if m.BatchRecordChangeCreateFunc != nil {
+		return m.BatchRecordChangeCreateFunc(change)
+	}
+	if m.BatchRecordChangeCreateWithContextFunc != nil {
+		return m.BatchRecordChangeCreateWithContextFunc(context.Background(), change)
+	}
+	var r0 *vinyldns.BatchRecordChangeUpdateResponse
+	return r0, notMocked("BatchRecordChangeCreate")
+}
+
+// BatchRecordChangeCreateWithContext records the call and invokes BatchRecordChangeCreateWithContextFunc.
+func (m *Mock) BatchRecordChangeCreateWithContext(ctx context.Context, change *vinyldns.BatchRecordChange) (*vinyldns.BatchRecordChangeUpdateResponse, error) {
+	m.record("BatchRecordChangeCreateWithContext", ctx, change)
+	if m.BatchRecordChangeCreateWithContextFunc != nil {
+		return m.BatchRecordChangeCreateWithContextFunc(ctx, change)
+	}
+	var r0 *vinyldns.BatchRecordChangeUpdateResponse
+	return r0, notMocked("BatchRecordChangeCreateWithContext")
+}
+
+// BatchRecordChangeSubmit records the call and invokes BatchRecordChangeSubmitFunc.
+func (m *Mock) BatchRecordChangeSubmit(change *vinyldns.BatchRecordChange, opts *vinyldns.BatchChangeCreateOptions) (*vinyldns.BatchRecordChangeUpdateResponse, error) {
+	m.record("BatchRecordChangeSubmit", change, opts)
+	if m.BatchRecordChangeSubmitFunc != nil {
+		return m.BatchRecordChangeSubmitFunc(change, opts)
+	}
+	if m.BatchRecordChangeSubmitWithContextFunc != nil {
+		return m.BatchRecordChangeSubmitWithContextFunc(context.Background(), change, opts)
+	}
+	var r0 *vinyldns.BatchRecordChangeUpdateResponse
+	return r0, notMocked("BatchRecordChangeSubmit")
+}
+
+// BatchRecordChangeSubmitWithContext records the call and invokes BatchRecordChangeSubmitWithContextFunc.
+func (m *Mock) BatchRecordChangeSubmitWithContext(ctx context.Context, change *vinyldns.BatchRecordChange, opts *vinyldns.BatchChangeCreateOptions) (*vinyldns.BatchRecordChangeUpdateResponse, error) {
+	m.record("BatchRecordChangeSubmitWithContext", ctx, change, opts)
+	if m.BatchRecordChangeSubmitWithContextFunc != nil {
+		return m.BatchRecordChangeSubmitWithContextFunc(ctx, change, opts)
+	}
+	var r0 *vinyldns.BatchRecordChangeUpdateResponse
+	return r0, notMocked("BatchRecordChangeSubmitWithContext")
+}
+
+// BatchRecordChangeCreateChunked records the call and invokes BatchRecordChangeCreateChunkedFunc.
+func (m *Mock) BatchRecordChangeCreateChunked(change *vinyldns.BatchRecordChange, opts *vinyldns.ChunkOptions) (*vinyldns.ChunkedBatchChangeResult, error) {
+	m.record("BatchRecordChangeCreateChunked", change, opts)
+	if m.BatchRecordChangeCreateChunkedFunc != nil {
+		return m.BatchRecordChangeCreateChunkedFunc(change, opts)
+	}
+	if m.BatchRecordChangeCreateChunkedWithContextFunc != nil {
+		return m.BatchRecordChangeCreateChunkedWithContextFunc(context.Background(), change, opts)
+	}
+	var r0 *vinyldns.ChunkedBatchChangeResult
+	return r0, notMocked("BatchRecordChangeCreateChunked")
+}
+
+// BatchRecordChangeCreateChunkedWithContext records the call and invokes BatchRecordChangeCreateChunkedWithContextFunc.
+func (m *Mock) BatchRecordChangeCreateChunkedWithContext(ctx context.Context, change *vinyldns.BatchRecordChange, opts *vinyldns.ChunkOptions) (*vinyldns.ChunkedBatchChangeResult, error) {
+	m.record("BatchRecordChangeCreateChunkedWithContext", ctx, change, opts)
+	if m.BatchRecordChangeCreateChunkedWithContextFunc != nil {
+		return m.BatchRecordChangeCreateChunkedWithContextFunc(ctx, change, opts)
+	}
+	var r0 *vinyldns.ChunkedBatchChangeResult
+	return r0, notMocked("BatchRecordChangeCreateChunkedWithContext")
+}
+
+// BatchRecordChangeApprove records the call and invokes BatchRecordChangeApproveFunc.
+func (m *Mock) BatchRecordChangeApprove(changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error) {
+	m.record("BatchRecordChangeApprove", changeID, review)
+	if m.BatchRecordChangeApproveFunc != nil {
+		return m.BatchRecordChangeApproveFunc(changeID, review)
+	}
+	if m.BatchRecordChangeApproveWithContextFunc != nil {
+		return m.BatchRecordChangeApproveWithContextFunc(context.Background(), changeID, review)
+	}
+	var r0 *vinyldns.BatchRecordChange
+	return r0, notMocked("BatchRecordChangeApprove")
+}
+
+// BatchRecordChangeApproveWithContext records the call and invokes BatchRecordChangeApproveWithContextFunc.
+func (m *Mock) BatchRecordChangeApproveWithContext(ctx context.Context, changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error) {
+	m.record("BatchRecordChangeApproveWithContext", ctx, changeID, review)
+	if m.BatchRecordChangeApproveWithContextFunc != nil {
+		return m.BatchRecordChangeApproveWithContextFunc(ctx, changeID, review)
+	}
+	var r0 *vinyldns.BatchRecordChange
+	return r0, notMocked("BatchRecordChangeApproveWithContext")
+}
+
+// BatchRecordChangeReject records the call and invokes BatchRecordChangeRejectFunc.
+func (m *Mock) BatchRecordChangeReject(changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error) {
+	m.record("BatchRecordChangeReject", changeID, review)
+	if m.BatchRecordChangeRejectFunc != nil {
+		return m.BatchRecordChangeRejectFunc(changeID, review)
+	}
+	if m.BatchRecordChangeRejectWithContextFunc != nil {
+		return m.BatchRecordChangeRejectWithContextFunc(context.Background(), changeID, review)
+	}
+	var r0 *vinyldns.BatchRecordChange
+	return r0, notMocked("BatchRecordChangeReject")
+}
+
+// BatchRecordChangeRejectWithContext records the call and invokes BatchRecordChangeRejectWithContextFunc.
+func (m *Mock) BatchRecordChangeRejectWithContext(ctx context.Context, changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error) {
+	m.record("BatchRecordChangeRejectWithContext", ctx, changeID, review)
+	if m.BatchRecordChangeRejectWithContextFunc != nil {
+		return m.BatchRecordChangeRejectWithContextFunc(ctx, changeID, review)
+	}
+	var r0 *vinyldns.BatchRecordChange
+	return r0, notMocked("BatchRecordChangeRejectWithContext")
+}
+
+// BatchRecordChangeCancel records the call and invokes BatchRecordChangeCancelFunc.
+func (m *Mock) BatchRecordChangeCancel(changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error) {
+	m.record("BatchRecordChangeCancel", changeID, review)
+	if m.BatchRecordChangeCancelFunc != nil {
+		return m.BatchRecordChangeCancelFunc(changeID, review)
+	}
+	if m.BatchRecordChangeCancelWithContextFunc != nil {
+		return m.BatchRecordChangeCancelWithContextFunc(context.Background(), changeID, review)
+	}
+	var r0 *vinyldns.BatchRecordChange
+	return r0, notMocked("BatchRecordChangeCancel")
+}
+
+// BatchRecordChangeCancelWithContext records the call and invokes BatchRecordChangeCancelWithContextFunc.
+func (m *Mock) BatchRecordChangeCancelWithContext(ctx context.Context, changeID string, review *vinyldns.BatchChangeReview) (*vinyldns.BatchRecordChange, error) {
+	m.record("BatchRecordChangeCancelWithContext", ctx, changeID, review)
+	if m.BatchRecordChangeCancelWithContextFunc != nil {
+		return m.BatchRecordChangeCancelWithContextFunc(ctx, changeID, review)
+	}
+	var r0 *vinyldns.BatchRecordChange
+	return r0, notMocked("BatchRecordChangeCancelWithContext")
+}
+
+// BatchRecordChangeRollbackPlan records the call and invokes BatchRecordChangeRollbackPlanFunc.
+func (m *Mock) BatchRecordChangeRollbackPlan(changeID string) (*vinyldns.BatchRollbackPlan, error) {
+	m.record("BatchRecordChangeRollbackPlan", changeID)
+	if m.BatchRecordChangeRollbackPlanFunc != nil {
+		return m.BatchRecordChangeRollbackPlanFunc(changeID)
+	}
+	if m.BatchRecordChangeRollbackPlanWithContextFunc != nil {
+		return m.BatchRecordChangeRollbackPlanWithContextFunc(context.Background(), changeID)
+	}
+	var r0 *vinyldns.BatchRollbackPlan
+	return r0, notMocked("BatchRecordChangeRollbackPlan")
+}
+
+// BatchRecordChangeRollbackPlanWithContext records the call and invokes BatchRecordChangeRollbackPlanWithContextFunc.
+func (m *Mock) BatchRecordChangeRollbackPlanWithContext(ctx context.Context, changeID string) (*vinyldns.BatchRollbackPlan, error) {
+	m.record("BatchRecordChangeRollbackPlanWithContext", ctx, changeID)
+	if m.BatchRecordChangeRollbackPlanWithContextFunc != nil {
+		return m.BatchRecordChangeRollbackPlanWithContextFunc(ctx, changeID)
+	}
+	var r0 *vinyldns.BatchRollbackPlan
+	return r0, notMocked("BatchRecordChangeRollbackPlanWithContext")
+}
+
+// BatchRecordChangeRollback records the call and invokes BatchRecordChangeRollbackFunc.
+func (m *Mock) BatchRecordChangeRollback(plan *vinyldns.BatchRollbackPlan) (*vinyldns.BatchRecordChangeUpdateResponse, error) {
+	m.record("BatchRecordChangeRollback", plan)
+	if m.BatchRecordChangeRollbackFunc != nil {
+		return m.BatchRecordChangeRollbackFunc(plan)
+	}
+	if m.BatchRecordChangeRollbackWithContextFunc != nil {
+		return m.BatchRecordChangeRollbackWithContextFunc(context.Background(), plan)
+	}
+	var r0 *vinyldns.BatchRecordChangeUpdateResponse
+	return r0, notMocked("BatchRecordChangeRollback")
+}
+
+// BatchRecordChangeRollbackWithContext records the call and invokes BatchRecordChangeRollbackWithContextFunc.
+func (m *Mock) BatchRecordChangeRollbackWithContext(ctx context.Context, plan *vinyldns.BatchRollbackPlan) (*vinyldns.BatchRecordChangeUpdateResponse, error) {
+	m.record("BatchRecordChangeRollbackWithContext", ctx, plan)
+	if m.BatchRecordChangeRollbackWithContextFunc != nil {
+		return m.BatchRecordChangeRollbackWithContextFunc(ctx, plan)
+	}
+	var r0 *vinyldns.BatchRecordChangeUpdateResponse
+	return r0, notMocked("BatchRecordChangeRollbackWithContext")
+}
+
+// WaitForBatchChange records the call and invokes WaitForBatchChangeFunc.
+func (m *Mock) WaitForBatchChange(ctx context.Context, changeID string, opts *vinyldns.WaitOptions) (*vinyldns.BatchRecordChange, error) {
+	m.record("WaitForBatchChange", ctx, changeID, opts)
+	if m.WaitForBatchChangeFunc != nil {
+		return m.WaitForBatchChangeFunc(ctx, changeID, opts)
+	}
+	var r0 *vinyldns.BatchRecordChange
+	return r0, notMocked("WaitForBatchChange")
+}
+
+// User records the call and invokes UserFunc.
+func (m *Mock) User(userIdentifier string) (vinyldns.UserInfo, error) {
+	m.record("User", userIdentifier)
+	if m.UserFunc != nil {
+		return m.UserFunc(userIdentifier)
+	}
+	if m.UserWithContextFunc != nil {
+		return m.UserWithContextFunc(context.Background(), userIdentifier)
+	}
+	var r0 vinyldns.UserInfo
+	return r0, notMocked("User")
+}
+
+// UserWithContext records the call and invokes UserWithContextFunc.
+func (m *Mock) UserWithContext(ctx context.Context, userIdentifier string) (vinyldns.UserInfo, error) {
+	m.record("UserWithContext", ctx, userIdentifier)
+	if m.UserWithContextFunc != nil {
+		return m.UserWithContextFunc(ctx, userIdentifier)
+	}
+	var r0 vinyldns.UserInfo
+	return r0, notMocked("UserWithContext")
+}
+
+// UserLock records the call and invokes UserLockFunc.
+func (m *Mock) UserLock(userID string) (vinyldns.UserInfo, error) {
+	m.record("UserLock", userID)
+	if m.UserLockFunc != nil {
+		return m.UserLockFunc(userID)
+	}
+	if m.UserLockWithContextFunc != nil {
+		return m.UserLockWithContextFunc(context.Background(), userID)
+	}
+	var r0 vinyldns.UserInfo
+	return r0, notMocked("UserLock")
+}
+
+// UserLockWithContext records the call and invokes UserLockWithContextFunc.
+func (m *Mock) UserLockWithContext(ctx context.Context, userID string) (vinyldns.UserInfo, error) {
+	m.record("UserLockWithContext", ctx, userID)
+	if m.UserLockWithContextFunc != nil {
+		return m.UserLockWithContextFunc(ctx, userID)
+	}
+	var r0 vinyldns.UserInfo
+	return r0, notMocked("UserLockWithContext")
+}
+
+// UserUnlock records the call and invokes UserUnlockFunc.
+func (m *Mock) UserUnlock(userID string) (vinyldns.UserInfo, error) {
+	m.record("UserUnlock", userID)
+	if m.UserUnlockFunc != nil {
+		return m.UserUnlockFunc(userID)
+	}
+	if m.UserUnlockWithContextFunc != nil {
+		return m.UserUnlockWithContextFunc(context.Background(), userID)
+	}
+	var r0 vinyldns.UserInfo
+	return r0, notMocked("UserUnlock")
+}
+
+// UserUnlockWithContext records the call and invokes UserUnlockWithContextFunc.
+func (m *Mock) UserUnlockWithContext(ctx context.Context, userID string) (vinyldns.UserInfo, error) {
+	m.record("UserUnlockWithContext", ctx, userID)
+	if m.UserUnlockWithContextFunc != nil {
+		return m.UserUnlockWithContextFunc(ctx, userID)
+	}
+	var r0 vinyldns.UserInfo
+	return r0, notMocked("UserUnlockWithContext")
+}
+
+// Status records the call and invokes StatusFunc.
+func (m *Mock) Status() (vinyldns.SystemStatus, error) {
+	m.record("Status")
+	if m.StatusFunc != nil {
+		return m.StatusFunc()
+	}
+	if m.StatusWithContextFunc != nil {
+		return m.StatusWithContextFunc(context.Background())
+	}
+	var r0 vinyldns.SystemStatus
+	return r0, notMocked("Status")
+}
+
+// StatusWithContext records the call and invokes StatusWithContextFunc.
+func (m *Mock) StatusWithContext(ctx context.Context) (vinyldns.SystemStatus, error) {
+	m.record("StatusWithContext", ctx)
+	if m.StatusWithContextFunc != nil {
+		return m.StatusWithContextFunc(ctx)
+	}
+	var r0 vinyldns.SystemStatus
+	return r0, notMocked("StatusWithContext")
+}
+
+// StatusUpdate records the call and invokes StatusUpdateFunc.
+func (m *Mock) StatusUpdate(processingDisabled bool) (vinyldns.SystemStatus, error) {
+	m.record("StatusUpdate", processingDisabled)
+	if m.StatusUpdateFunc != nil {
+		return m.StatusUpdateFunc(processingDisabled)
+	}
+	if m.StatusUpdateWithContextFunc != nil {
+		return m.StatusUpdateWithContextFunc(context.Background(), processingDisabled)
+	}
+	var r0 vinyldns.SystemStatus
+	return r0, notMocked("StatusUpdate")
+}
+
+// StatusUpdateWithContext records the call and invokes StatusUpdateWithContextFunc.
+func (m *Mock) StatusUpdateWithContext(ctx context.Context, processingDisabled bool) (vinyldns.SystemStatus, error) {
+	m.record("StatusUpdateWithContext", ctx, processingDisabled)
+	if m.StatusUpdateWithContextFunc != nil {
+		return m.StatusUpdateWithContextFunc(ctx, processingDisabled)
+	}
+	var r0 vinyldns.SystemStatus
+	return r0, notMocked("StatusUpdateWithContext")
+}
+
+// Ping records the call and invokes PingFunc.
+func (m *Mock) Ping() (string, error) {
+	m.record("Ping")
+	if m.PingFunc != nil {
+		return m.PingFunc()
+	}
+	if m.PingWithContextFunc != nil {
+		return m.PingWithContextFunc(context.Background())
+	}
+	var r0 string
+	return r0, notMocked("Ping")
+}
+
+// PingWithContext records the call and invokes PingWithContextFunc.
+func (m *Mock) PingWithContext(ctx context.Context) (string, error) {
+	m.record("PingWithContext", ctx)
+	if m.PingWithContextFunc != nil {
+		return m.PingWithContextFunc(ctx)
+	}
+	var r0 string
+	return r0, notMocked("PingWithContext")
+}
+
+// Health records the call and invokes HealthFunc.
+func (m *Mock) Health() error {
+	m.record("Health")
+	if m.HealthFunc != nil {
+		return m.HealthFunc()
+	}
+	if m.HealthWithContextFunc != nil {
+		return m.HealthWithContextFunc(context.Background())
+	}
+	return notMocked("Health")
+}
+
+// HealthWithContext records the call and invokes HealthWithContextFunc.
+func (m *Mock) HealthWithContext(ctx context.Context) error {
+	m.record("HealthWithContext", ctx)
+	if m.HealthWithContextFunc != nil {
+		return m.HealthWithContextFunc(ctx)
+	}
+	return notMocked("HealthWithContext")
+}
+
+// Color records the call and invokes ColorFunc.
+func (m *Mock) Color() (string, error) {
+	m.record("Color")
+	if m.ColorFunc != nil {
+		return m.ColorFunc()
+	}
+	if m.ColorWithContextFunc != nil {
+		return m.ColorWithContextFunc(context.Background())
+	}
+	var r0 string
+	return r0, notMocked("Color")
+}
+
+// ColorWithContext records the call and invokes ColorWithContextFunc.
+func (m *Mock) ColorWithContext(ctx context.Context) (string, error) {
+	m.record("ColorWithContext", ctx)
+	if m.ColorWithContextFunc != nil {
+		return m.ColorWithContextFunc(ctx)
+	}
+	var r0 string
+	return r0, notMocked("ColorWithContext")
+}
+
+// MetricsPrometheus records the call and invokes MetricsPrometheusFunc.
+func (m *Mock) MetricsPrometheus(names []string) (string, error) {
+	m.record("MetricsPrometheus", names)
+	if m.MetricsPrometheusFunc != nil {
+		return m.MetricsPrometheusFunc(names)
+	}
+	if m.MetricsPrometheusWithContextFunc != nil {
+		return m.MetricsPrometheusWithContextFunc(context.Background(), names)
+	}
+	var r0 string
+	return r0, notMocked("MetricsPrometheus")
+}
+
+// MetricsPrometheusWithContext records the call and invokes MetricsPrometheusWithContextFunc.
+func (m *Mock) MetricsPrometheusWithContext(ctx context.Context, names []string) (string, error) {
+	m.record("MetricsPrometheusWithContext", ctx, names)
+	if m.MetricsPrometheusWithContextFunc != nil {
+		return m.MetricsPrometheusWithContextFunc(ctx, names)
+	}
+	var r0 string
+	return r0, notMocked("MetricsPrometheusWithContext")
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldnstest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+// ensureZone is an example of consumer code depending on a narrow API interface.
+func ensureZone(api vinyldns.ZonesAPI, zone *vinyldns.Zone) error {
+	exists, err := api.ZoneNameExists(zone.Name)
+	if err != nil || exists {
+		return err
+	}
+	_, err = api.ZoneCreate(zone)
+
+	return err
+}
+
+func TestMockRecordsCalls(t *testing.T) {
+	m := &Mock{
+		ZoneNameExistsFunc: func(name string) (bool, error) {
+			return false, nil
+		},
+		ZoneCreateFunc: func(z *vinyldns.Zone) (*vinyldns.ZoneUpdateResponse, error) {
+			return &vinyldns.ZoneUpdateResponse{Zone: *z, Status: "Pending"}, nil
+		},
+	}
+
+	if err := ensureZone(m, &vinyldns.Zone{Name: "ok."}); err != nil {
+		t.Fatal(err)
+	}
+
+	calls := m.Calls()
+	if len(calls) != 2 || calls[0].Method != "ZoneNameExists" || calls[1].Method != "ZoneCreate" {
+		t.Fatalf("Expected ZoneNameExists then ZoneCreate; got %v", calls)
+	}
+	if calls[0].Args[0] != "ok." {
+		t.Errorf("Expected ZoneNameExists to be called with ok.; got %v", calls[0].Args)
+	}
+	if z := m.CallsTo("ZoneCreate")[0].Args[0].(*vinyldns.Zone); z.Name != "ok." {
+		t.Errorf("Expected ZoneCreate to be called with zone ok.; got %s", z.Name)
+	}
+
+	m.Reset()
+	if len(m.Calls()) != 0 {
+		t.Error("Expected Reset to forget recorded calls")
+	}
+}
+
+func TestMockFallsBackToWithContext(t *testing.T) {
+	m := &Mock{
+		ZoneByNameWithContextFunc: func(ctx context.Context, name string) (vinyldns.Zone, error) {
+			return vinyldns.Zone{Name: name, ID: "123"}, nil
+		},
+	}
+
+	z, err := m.ZoneByName("ok.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if z.ID != "123" {
+		t.Errorf("Expected ZoneByNameWithContextFunc to be used; got %v", z)
+	}
+	if calls := m.CallsTo("ZoneByName"); len(calls) != 1 {
+		t.Errorf("Expected a single ZoneByName call; got %v", m.Calls())
+	}
+}
+
+func TestMockNotMocked(t *testing.T) {
+	m := &Mock{}
+
+	if _, err := m.RecordSetCreate(&vinyldns.RecordSet{}); !errors.Is(err, ErrNotMocked) {
+		t.Errorf("Expected ErrNotMocked; got %v", err)
+	}
+
+	for _, err := range m.ZonesIter(context.Background(), vinyldns.ListFilter{}) {
+		if !errors.Is(err, ErrNotMocked) {
+			t.Errorf("Expected ZonesIter to yield ErrNotMocked; got %v", err)
+		}
+	}
+
+	for _, err := range m.RecordSetChangesPager(context.Background(), "123", vinyldns.ListFilterRecordSetChanges{}).All() {
+		if !errors.Is(err, ErrNotMocked) {
+			t.Errorf("Expected RecordSetChangesPager to yield ErrNotMocked; got %v", err)
+		}
+	}
+}
@@ -0,0 +1,250 @@
+//go:build ignore
+
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// mockgen generates mock_generated.go from the API interfaces in ../api.go.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"strings"
+	"unicode"
+)
+
+type param struct {
+	name string
+	typ  string
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "../api.go", nil, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	interfaces := map[string]*ast.InterfaceType{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		if ts, ok := n.(*ast.TypeSpec); ok {
+			if it, ok := ts.Type.(*ast.InterfaceType); ok {
+				interfaces[ts.Name.Name] = it
+			}
+		}
+		return true
+	})
+
+	api, ok := interfaces["API"]
+	if !ok {
+		log.Fatal("api.go has no API interface")
+	}
+
+	methods := []method{}
+	for _, embedded := range api.Methods.List {
+		name := embedded.Type.(*ast.Ident).Name
+		for _, field := range interfaces[name].Methods.List {
+			methods = append(methods, newMethod(fset, field))
+		}
+	}
+
+	names := map[string]bool{}
+	for _, m := range methods {
+		names[m.name] = true
+	}
+
+	imports := []string{}
+	for _, spec := range f.Imports {
+		imports = append(imports, spec.Path.Value)
+	}
+
+	src := generate(imports, methods, names)
+	out, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("formatting generated source: %s\n%s", err, src)
+	}
+	if err := os.WriteFile("mock_generated.go", out, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func newMethod(fset *token.FileSet, field *ast.Field) method {
+	ft := field.Type.(*ast.FuncType)
+	m := method{name: field.Names[0].Name}
+
+	for _, p := range ft.Params.List {
+		typ := typeString(fset, p.Type)
+		for _, n := range p.Names {
+			m.params = append(m.params, param{name: n.Name, typ: typ})
+		}
+	}
+	if ft.Results != nil {
+		for _, r := range ft.Results.List {
+			m.results = append(m.results, typeString(fset, r.Type))
+		}
+	}
+
+	return m
+}
+
+// typeString prints expr with the vinyldns package's own types qualified.
+func typeString(fset *token.FileSet, expr ast.Expr) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, qualify(expr)); err != nil {
+		log.Fatal(err)
+	}
+
+	return buf.String()
+}
+
+func qualify(expr ast.Expr) ast.Expr {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		if unicode.IsUpper(rune(e.Name[0])) {
+			return &ast.SelectorExpr{X: ast.NewIdent("vinyldns"), Sel: ast.NewIdent(e.Name)}
+		}
+		return e
+	case *ast.StarExpr:
+		return &ast.StarExpr{X: qualify(e.X)}
+	case *ast.ArrayType:
+		return &ast.ArrayType{Len: e.Len, Elt: qualify(e.Elt)}
+	case *ast.MapType:
+		return &ast.MapType{Key: qualify(e.Key), Value: qualify(e.Value)}
+	case *ast.Ellipsis:
+		return &ast.Ellipsis{Elt: qualify(e.Elt)}
+	case *ast.IndexExpr:
+		return &ast.IndexExpr{X: qualify(e.X), Index: qualify(e.Index)}
+	case *ast.IndexListExpr:
+		indices := []ast.Expr{}
+		for _, i := range e.Indices {
+			indices = append(indices, qualify(i))
+		}
+		return &ast.IndexListExpr{X: qualify(e.X), Indices: indices}
+	case *ast.FuncType:
+		return &ast.FuncType{Params: qualifyFields(e.Params), Results: qualifyFields(e.Results)}
+	}
+
+	return expr
+}
+
+func qualifyFields(fl *ast.FieldList) *ast.FieldList {
+	if fl == nil {
+		return nil
+	}
+	out := &ast.FieldList{}
+	for _, f := range fl.List {
+		out.List = append(out.List, &ast.Field{Names: f.Names, Type: qualify(f.Type)})
+	}
+
+	return out
+}
+
+func generate(imports []string, methods []method, names map[string]bool) []byte {
+	var b bytes.Buffer
+	header, err := os.ReadFile("mock.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+	license := string(header[:bytes.Index(header, []byte("*/"))+2])
+
+	fmt.Fprintf(&b, "%s\n\n// Code generated by mockgen.go; DO NOT EDIT.\n\npackage vinyldnstest\n\n", license)
+	fmt.Fprintf(&b, "import (\n%s\n\n\"github.com/vinyldns/go-vinyldns/vinyldns\"\n)\n\n", strings.Join(imports, "\n"))
+	b.WriteString("var _ vinyldns.API = (*Mock)(nil)\n\n")
+	b.WriteString(`// Mock is a vinyldns.API whose methods record each call and then
+// delegate to the corresponding Func field. A method whose Func field is
+// not set falls back to the Func field of its WithContext variant, if any,
+// and otherwise returns zero values and an error wrapping ErrNotMocked.
+type Mock struct {
+	callRecorder
+
+`)
+	for _, m := range methods {
+		fmt.Fprintf(&b, "%sFunc func(%s) %s\n", m.name, paramList(m.params), resultList(m.results))
+	}
+	b.WriteString("}\n")
+
+	for _, m := range methods {
+		args := []string{}
+		for _, p := range m.params {
+			args = append(args, p.name)
+		}
+		call := strings.Join(args, ", ")
+
+		fmt.Fprintf(&b, "\n// %s records the call and invokes %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(&b, "func (m *Mock) %s(%s) %s {\n", m.name, paramList(m.params), resultList(m.results))
+		fmt.Fprintf(&b, "m.record(%q", m.name)
+		for _, a := range args {
+			fmt.Fprintf(&b, ", %s", a)
+		}
+		b.WriteString(")\n")
+		fmt.Fprintf(&b, "if m.%sFunc != nil {\nreturn m.%sFunc(%s)\n}\n", m.name, m.name, call)
+		if ctxVariant := m.name + "WithContext"; names[ctxVariant] {
+			fmt.Fprintf(&b, "if m.%sFunc != nil {\nreturn m.%sFunc(%s)\n}\n", ctxVariant, ctxVariant, strings.Join(append([]string{"context.Background()"}, args...), ", "))
+		}
+		b.WriteString(zeroReturn(m))
+		b.WriteString("}\n")
+	}
+
+	return b.Bytes()
+}
+
+func paramList(params []param) string {
+	ps := []string{}
+	for _, p := range params {
+		ps = append(ps, p.name+" "+p.typ)
+	}
+
+	return strings.Join(ps, ", ")
+}
+
+func resultList(results []string) string {
+	if len(results) == 1 {
+		return results[0]
+	}
+
+	return "(" + strings.Join(results, ", ") + ")"
+}
+
+// zeroReturn returns the statements returning from an unmocked method.
+func zeroReturn(m method) string {
+	var b strings.Builder
+	values := []string{}
+	for i, r := range m.results {
+		switch {
+		case r == "error":
+			values = append(values, fmt.Sprintf("notMocked(%q)", m.name))
+		case strings.HasPrefix(r, "iter.Seq2["):
+			values = append(values, fmt.Sprintf("notMockedSeq[%s](%q)", strings.TrimSuffix(strings.TrimPrefix(r, "iter.Seq2["), ", error]"), m.name))
+		case strings.HasPrefix(r, "*vinyldns.Pager["):
+			values = append(values, fmt.Sprintf("notMockedPager[%s](%q)", strings.TrimSuffix(strings.TrimPrefix(r, "*vinyldns.Pager["), "]"), m.name))
+		default:
+			fmt.Fprintf(&b, "var r%d %s\n", i, r)
+			values = append(values, fmt.Sprintf("r%d", i))
+		}
+	}
+	fmt.Fprintf(&b, "return %s\n", strings.Join(values, ", "))
+
+	return b.String()
+}
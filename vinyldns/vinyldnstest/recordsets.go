@@ -0,0 +1,420 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldnstest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+// AddRecordSet adds a record set directly to the server's state,
+// bypassing the conflict validation a create request is subject to.
+// The record set's zone must already exist.
+func (s *Server) AddRecordSet(rs vinyldns.RecordSet) (vinyldns.RecordSet, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.zones[rs.ZoneID]; !ok {
+		return rs, fmt.Errorf("zone %s does not exist", rs.ZoneID)
+	}
+	rs.ID = ""
+	s.putRecordSet(&rs)
+
+	return rs, nil
+}
+
+// putRecordSet stores rs, filling in the fields VinylDNS computes.
+func (s *Server) putRecordSet(rs *vinyldns.RecordSet) {
+	zone := s.zones[rs.ZoneID]
+	if rs.ID == "" {
+		rs.ID = newID()
+		rs.Created = now()
+	}
+	rs.Name = relativeName(rs.Name, zone.Name)
+	rs.FQDN = fqdn(rs.Name, zone.Name)
+	rs.ZoneName = zone.Name
+	rs.Account = "system"
+	rs.Status = "Active"
+	shared := zone.Shared
+	rs.IsShared = &shared
+
+	stored := *rs
+	s.recordSets[rs.ID] = &stored
+}
+
+// relativeName returns name relative to the zone, using the
+// zone name itself for the apex as VinylDNS does.
+func relativeName(name, zoneName string) string {
+	if name == "" || name == "@" || strings.EqualFold(ensureDot(name), zoneName) {
+		return zoneName
+	}
+	if strings.HasSuffix(name, ".") {
+		suffix := "." + zoneName
+		if len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+			return name[:len(name)-len(suffix)]
+		}
+	}
+
+	return name
+}
+
+func fqdn(name, zoneName string) string {
+	if strings.EqualFold(name, zoneName) {
+		return zoneName
+	}
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "." + zoneName
+}
+
+func (s *Server) routeRecordSets(w http.ResponseWriter, r *http.Request, zone *vinyldns.Zone, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listRecordSets(w, r, zone)
+		case http.MethodPost:
+			s.createRecordSet(w, r, zone)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	// A record set's changes outlive it, so a deleted record set's changes can be polled.
+	if len(segs) == 3 && segs[1] == "changes" && r.Method == http.MethodGet {
+		for _, change := range s.recordSetChanges[zone.ID] {
+			if change.ID == segs[2] && change.RecordSet.ID == segs[0] {
+				writeJSON(w, http.StatusOK, change)
+				return
+			}
+		}
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Unable to find record set change with id %s", segs[2]))
+		return
+	}
+
+	rs, ok := s.recordSets[segs[0]]
+	if !ok || rs.ZoneID != zone.ID {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("RecordSet with id %s does not exist in zone %s", segs[0], zone.Name))
+		return
+	}
+	if len(segs) != 1 {
+		notFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, vinyldns.RecordSetResponse{RecordSet: *rs})
+	case http.MethodPut:
+		s.updateRecordSet(w, r, zone, rs)
+	case http.MethodDelete:
+		delete(s.recordSets, rs.ID)
+		change := s.recordRecordSetChange(zone, *rs, vinyldns.RecordSet{}, "Delete")
+		writeJSON(w, http.StatusAccepted, recordSetUpdateResponse(change))
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) listRecordSets(w http.ResponseWriter, r *http.Request, zone *vinyldns.Zone) {
+	p, err := parsePage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	f := parseRecordSetFilter(r)
+
+	// Record sets are matched and sorted by name, then type.
+	recordSets := []vinyldns.RecordSet{}
+	for _, rs := range s.recordSets {
+		if rs.ZoneID == zone.ID && f.match(rs, rs.Name) {
+			recordSets = append(recordSets, *rs)
+		}
+	}
+	sort.Slice(recordSets, func(i, j int) bool {
+		if recordSets[i].Name == recordSets[j].Name {
+			return recordSets[i].Type < recordSets[j].Type
+		}
+		return f.less(recordSets[i].Name, recordSets[j].Name)
+	})
+
+	lo, hi, next := p.bounds(len(recordSets))
+	writeJSON(w, http.StatusOK, vinyldns.RecordSetsResponse{
+		RecordSets:       recordSets[lo:hi],
+		StartFrom:        cursor(p.start),
+		MaxItems:         p.maxItems,
+		NextID:           cursor(next),
+		RecordNameFilter: f.name,
+	})
+}
+
+// recordSetFilter holds the recordNameFilter, recordTypeFilter,
+// recordOwnerGroupFilter and nameSort params of a record set list request.
+type recordSetFilter struct {
+	name  string
+	types map[string]bool
+	owner string
+	desc  bool
+}
+
+func parseRecordSetFilter(r *http.Request) recordSetFilter {
+	q := r.URL.Query()
+	f := recordSetFilter{
+		name:  q.Get("recordNameFilter"),
+		types: map[string]bool{},
+		owner: q.Get("recordOwnerGroupFilter"),
+		desc:  vinyldns.NameSort(strings.ToUpper(q.Get("nameSort"))) == vinyldns.DESC,
+	}
+	for _, t := range strings.Split(q.Get("recordTypeFilter"), ",") {
+		if t != "" {
+			f.types[t] = true
+		}
+	}
+
+	return f
+}
+
+// match reports whether the record set, known by the name
+// it's passed, matches the filter.
+func (f recordSetFilter) match(rs *vinyldns.RecordSet, name string) bool {
+	if f.name != "" && !containsFold(name, f.name) {
+		return false
+	}
+	if len(f.types) != 0 && !f.types[rs.Type] {
+		return false
+	}
+
+	return f.owner == "" || rs.OwnerGroupID == f.owner
+}
+
+// less orders names in the filter's nameSort order.
+func (f recordSetFilter) less(a, b string) bool {
+	if f.desc {
+		return a > b
+	}
+
+	return a < b
+}
+
+func (s *Server) createRecordSet(w http.ResponseWriter, r *http.Request, zone *vinyldns.Zone) {
+	rs := vinyldns.RecordSet{}
+	if !decode(w, r, &rs) {
+		return
+	}
+	if rs.ZoneID != zone.ID {
+		writeError(w, http.StatusBadRequest, "Cannot create a record set in a different zone")
+		return
+	}
+	if rs.Type == "" {
+		writeError(w, http.StatusBadRequest, "Missing RecordSet.type")
+		return
+	}
+
+	rs.ID = ""
+	rs.Name = relativeName(rs.Name, zone.Name)
+	if code, msg := s.validateRecordSet(zone, rs); code != 0 {
+		writeError(w, code, msg)
+		return
+	}
+
+	s.putRecordSet(&rs)
+	change := s.recordRecordSetChange(zone, rs, vinyldns.RecordSet{}, "Create")
+	writeJSON(w, http.StatusAccepted, recordSetUpdateResponse(change))
+}
+
+func (s *Server) updateRecordSet(w http.ResponseWriter, r *http.Request, zone *vinyldns.Zone, existing *vinyldns.RecordSet) {
+	rs := vinyldns.RecordSet{}
+	if !decode(w, r, &rs) {
+		return
+	}
+	if rs.ID != "" && rs.ID != existing.ID {
+		writeError(w, http.StatusBadRequest, "Cannot update RecordSet's ID")
+		return
+	}
+	if rs.Type != existing.Type {
+		writeError(w, http.StatusBadRequest, "Cannot update RecordSet's record type")
+		return
+	}
+
+	rs.ID = existing.ID
+	rs.ZoneID = zone.ID
+	rs.Created = existing.Created
+	rs.Updated = now()
+	rs.Name = relativeName(rs.Name, zone.Name)
+	if code, msg := s.validateRecordSet(zone, rs); code != 0 {
+		writeError(w, code, msg)
+		return
+	}
+
+	old := *existing
+	s.putRecordSet(&rs)
+	change := s.recordRecordSetChange(zone, rs, old, "Update")
+	writeJSON(w, http.StatusAccepted, recordSetUpdateResponse(change))
+}
+
+// validateRecordSet checks rs against the other record sets in the zone,
+// returning the status code and message of the failure, if any.
+func (s *Server) validateRecordSet(zone *vinyldns.Zone, rs vinyldns.RecordSet) (int, string) {
+	for _, other := range s.recordSets {
+		if other.ZoneID != zone.ID || other.ID == rs.ID || !strings.EqualFold(other.Name, rs.Name) {
+			continue
+		}
+		if other.Type == rs.Type {
+			return http.StatusConflict, fmt.Sprintf("RecordSet with name %s and type %s already exists in zone %s", rs.Name, rs.Type, zone.Name)
+		}
+		if other.Type == "CNAME" || rs.Type == "CNAME" {
+			return http.StatusUnprocessableEntity, fmt.Sprintf("RecordSet with name %s and type CNAME already exists in zone %s", rs.Name, zone.Name)
+		}
+	}
+
+	return 0, ""
+}
+
+// recordRecordSetChange records a completed change to rs, whose state prior to
+// the change was old, and returns it.
+func (s *Server) recordRecordSetChange(zone *vinyldns.Zone, rs, old vinyldns.RecordSet, changeType string) vinyldns.RecordSetChange {
+	if changeType == "Delete" {
+		old = rs
+	}
+	change := vinyldns.RecordSetChange{
+		Zone:       *zone,
+		RecordSet:  rs,
+		Updates:    old,
+		UserID:     DefaultUserID,
+		UserName:   DefaultUserID,
+		ChangeType: changeType,
+		Status:     "Complete",
+		Created:    now(),
+		ID:         newID(),
+	}
+	s.recordSetChanges[zone.ID] = append([]vinyldns.RecordSetChange{change}, s.recordSetChanges[zone.ID]...)
+
+	return change
+}
+
+func recordSetUpdateResponse(change vinyldns.RecordSetChange) vinyldns.RecordSetUpdateResponse {
+	rs := change.RecordSet
+	rs.Status = "Pending" + change.ChangeType
+
+	return vinyldns.RecordSetUpdateResponse{
+		Zone:      change.Zone,
+		RecordSet: rs,
+		ChangeID:  change.ID,
+		Status:    "Pending",
+	}
+}
+
+func (s *Server) listRecordSetChanges(w http.ResponseWriter, r *http.Request, zone *vinyldns.Zone) {
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	p, err := parsePage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	changes := s.recordSetChanges[zone.ID]
+	lo, hi, next := p.bounds(len(changes))
+	writeJSON(w, http.StatusOK, vinyldns.RecordSetChanges{
+		RecordSetChanges: changes[lo:hi],
+		ZoneID:           zone.ID,
+		StartFrom:        p.start,
+		NextID:           next,
+		MaxItems:         p.maxItems,
+	})
+}
+
+func (s *Server) handleRecordSetsGlobal(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) != 0 {
+		notFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	p, err := parsePage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	f := parseRecordSetFilter(r)
+
+	// Record sets are matched and sorted by FQDN.
+	recordSets := []vinyldns.RecordSet{}
+	for _, rs := range s.recordSets {
+		if f.match(rs, rs.FQDN) {
+			recordSets = append(recordSets, *rs)
+		}
+	}
+	sort.Slice(recordSets, func(i, j int) bool {
+		return f.less(recordSets[i].FQDN, recordSets[j].FQDN)
+	})
+
+	lo, hi, next := p.bounds(len(recordSets))
+	writeJSON(w, http.StatusOK, vinyldns.RecordSetsResponse{
+		RecordSets:       recordSets[lo:hi],
+		StartFrom:        cursor(p.start),
+		MaxItems:         p.maxItems,
+		NextID:           cursor(next),
+		RecordNameFilter: f.name,
+	})
+}
+
+func (s *Server) handleRecordSetChangeHistory(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) != 1 || segs[0] != "history" {
+		notFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	p, err := parsePage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	q := r.URL.Query()
+	zoneID, name, recordType := q.Get("zoneId"), q.Get("fqdn"), q.Get("recordType")
+	if zoneID == "" || name == "" || recordType == "" {
+		writeError(w, http.StatusBadRequest, "zoneId, fqdn and recordType are required")
+		return
+	}
+
+	changes := []vinyldns.RecordSetChange{}
+	for _, change := range s.recordSetChanges[zoneID] {
+		if strings.EqualFold(change.RecordSet.FQDN, ensureDot(name)) && change.RecordSet.Type == recordType {
+			changes = append(changes, change)
+		}
+	}
+
+	lo, hi, next := p.bounds(len(changes))
+	writeJSON(w, http.StatusOK, vinyldns.RecordSetChanges{
+		RecordSetChanges: changes[lo:hi],
+		ZoneID:           zoneID,
+		StartFrom:        p.start,
+		NextID:           next,
+		MaxItems:         p.maxItems,
+	})
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vinyldnstest provides a stateful, in-memory fake of the VinylDNS API
+// for testing code built on the vinyldns package without a running VinylDNS.
+//
+// The fake applies changes synchronously: create, update and delete responses
+// report a Pending status, as VinylDNS does, while subsequent reads of the
+// change report it as Complete (or Synced, for zones).
+package vinyldnstest
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+// DefaultUserID is the ID and user name of the user that every
+// request to the fake server is attributed to.
+const DefaultUserID = "ok"
+
+// maxItemsLimit mirrors the VinylDNS page size limit.
+const maxItemsLimit = 100
+
+// Server is an in-memory fake VinylDNS API server.
+type Server struct {
+	// URL is the base URL of the running server, suitable for
+	// use as a vinyldns.ClientConfiguration Host.
+	URL string
+
+	srv *httptest.Server
+
+	mu               sync.Mutex
+	zones            map[string]*vinyldns.Zone
+	deletedZones     []vinyldns.ZoneChange
+	zoneChanges      map[string][]vinyldns.ZoneChange
+	recordSets       map[string]*vinyldns.RecordSet
+	recordSetChanges map[string][]vinyldns.RecordSetChange
+	groups           map[string]*vinyldns.Group
+	groupChanges     []vinyldns.GroupChange
+	batchChanges     []*vinyldns.BatchRecordChange
+	holdForReview    bool
+	batchChangeLimit int
+	scheduledChanges bool
+	users            map[string]*vinyldns.UserInfo
+	status           vinyldns.SystemStatus
+}
+
+// NewServer starts and returns a new fake VinylDNS server.
+// The caller should call Close when finished, to shut it down.
+func NewServer() *Server {
+	s := &Server{
+		zones:            map[string]*vinyldns.Zone{},
+		zoneChanges:      map[string][]vinyldns.ZoneChange{},
+		recordSets:       map[string]*vinyldns.RecordSet{},
+		recordSetChanges: map[string][]vinyldns.RecordSetChange{},
+		groups:           map[string]*vinyldns.Group{},
+		users: map[string]*vinyldns.UserInfo{
+			DefaultUserID: {ID: DefaultUserID, UserName: DefaultUserID, LockStatus: "Unlocked"},
+		},
+		status:           vinyldns.SystemStatus{Color: "blue", Version: "vinyldnstest"},
+		batchChangeLimit: vinyldns.DefaultBatchChangeLimit,
+	}
+	s.srv = httptest.NewServer(s)
+	s.URL = s.srv.URL
+
+	return s
+}
+
+// Close shuts down the server.
+func (s *Server) Close() {
+	s.srv.Close()
+}
+
+// Client returns a vinyldns.Client configured to talk to the server.
+func (s *Server) Client() *vinyldns.Client {
+	return vinyldns.NewClient(vinyldns.ClientConfiguration{
+		AccessKey: "testAccessKey",
+		SecretKey: "testSecretKey",
+		Host:      s.URL,
+		UserAgent: "vinyldnstest",
+	})
+}
+
+// ServeHTTP routes requests to the fake VinylDNS API handlers.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segs := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch segs[0] {
+	case "zones":
+		s.routeZones(w, r, segs[1:])
+	case "recordsets":
+		s.handleRecordSetsGlobal(w, r, segs[1:])
+	case "recordsetchange":
+		s.handleRecordSetChangeHistory(w, r, segs[1:])
+	case "groups":
+		s.routeGroups(w, r, segs[1:])
+	case "users":
+		s.routeUsers(w, r, segs[1:])
+	case "status":
+		s.handleStatus(w, r, segs[1:])
+	case "ping":
+		s.handlePlain(w, r, segs[1:], "PONG")
+	case "health":
+		s.handlePlain(w, r, segs[1:], "")
+	case "color":
+		s.handlePlain(w, r, segs[1:], s.status.Color)
+	case "metrics":
+		s.routeMetrics(w, r, segs[1:])
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) handlePlain(w http.ResponseWriter, r *http.Request, segs []string, body string) {
+	if len(segs) != 0 {
+		notFound(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		methodNotAllowed(w)
+		return
+	}
+	fmt.Fprint(w, body)
+}
+
+// routeMetrics serves Prometheus metrics as an empty exposition and reports
+// no failed changes, since the fake server applies every change it accepts.
+func (s *Server) routeMetrics(w http.ResponseWriter, r *http.Request, segs []string) {
+	switch {
+	case len(segs) == 1 && segs[0] == "prometheus":
+		s.handlePlain(w, r, nil, "")
+	case len(segs) == 2 && segs[0] == "health" && segs[1] == "zonechangesfailure":
+		writeJSON(w, http.StatusOK, vinyldns.ZoneChangeFailuresResponse{FailedZoneChanges: []vinyldns.ZoneChange{}})
+	case len(segs) == 4 && segs[0] == "health" && segs[1] == "zones" && segs[3] == "recordsetchangesfailure":
+		writeJSON(w, http.StatusOK, vinyldns.RecordSetChangeFailuresResponse{FailedRecordSetChanges: []vinyldns.RecordSetChange{}})
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) != 0 {
+		notFound(w, r)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, s.status)
+	case http.MethodPost:
+		disabled, err := strconv.ParseBool(r.URL.Query().Get("processingDisabled"))
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "processingDisabled must be true or false")
+			return
+		}
+		s.status.ProcessingDisabled = disabled
+		writeJSON(w, http.StatusOK, s.status)
+	default:
+		methodNotAllowed(w)
+	}
+}
+
+func (s *Server) routeUsers(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		notFound(w, r)
+		return
+	}
+
+	user := s.findUser(segs[0])
+	if user == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("User %s was not found", segs[0]))
+		return
+	}
+
+	switch {
+	case len(segs) == 1 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, user)
+	case len(segs) == 2 && segs[1] == "lock" && r.Method == http.MethodPut:
+		user.LockStatus = "Locked"
+		writeJSON(w, http.StatusOK, user)
+	case len(segs) == 2 && segs[1] == "unlock" && r.Method == http.MethodPut:
+		user.LockStatus = "Unlocked"
+		writeJSON(w, http.StatusOK, user)
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) findUser(identifier string) *vinyldns.UserInfo {
+	if u, ok := s.users[identifier]; ok {
+		return u
+	}
+	for _, u := range s.users {
+		if u.UserName == identifier {
+			return u
+		}
+	}
+
+	return nil
+}
+
+// AddUser adds a user the fake server can look up, lock and unlock.
+func (s *Server) AddUser(u vinyldns.UserInfo) vinyldns.UserInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if u.ID == "" {
+		u.ID = newID()
+	}
+	if u.LockStatus == "" {
+		u.LockStatus = "Unlocked"
+	}
+	s.users[u.ID] = &u
+
+	return u
+}
+
+// page represents the startFrom/maxItems parameters of a list request.
+type page struct {
+	start    int
+	maxItems int
+}
+
+// parsePage reads the startFrom and maxItems query parameters.
+// The fake server's startFrom cursors are offsets into the full listing.
+func parsePage(r *http.Request) (page, error) {
+	p := page{maxItems: maxItemsLimit}
+	q := r.URL.Query()
+
+	if v := q.Get("startFrom"); v != "" {
+		start, err := strconv.Atoi(v)
+		if err != nil || start < 0 {
+			return p, fmt.Errorf("startFrom %q is invalid", v)
+		}
+		p.start = start
+	}
+
+	if v := q.Get("maxItems"); v != "" {
+		max, err := strconv.Atoi(v)
+		if err != nil || max < 1 || max > maxItemsLimit {
+			return p, fmt.Errorf("maxItems was %s, maxItems must be between 0 exclusive and %d inclusive", v, maxItemsLimit)
+		}
+		p.maxItems = max
+	}
+
+	return p, nil
+}
+
+// bounds returns the slice bounds of the page within a listing of n items
+// and the offset of the following page, or 0 if this is the last page.
+func (p page) bounds(n int) (int, int, int) {
+	lo := p.start
+	if lo > n {
+		lo = n
+	}
+	hi := lo + p.maxItems
+	if hi >= n {
+		return lo, n, 0
+	}
+
+	return lo, hi, hi
+}
+
+// cursor formats an offset as a string startFrom/nextId,
+// using "" for the absence of one.
+func cursor(offset int) string {
+	if offset == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(offset)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, msg string) {
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(code)
+	fmt.Fprint(w, msg)
+}
+
+func notFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotFound, fmt.Sprintf("The requested path [%s] does not exist.", r.URL.Path))
+}
+
+func methodNotAllowed(w http.ResponseWriter) {
+	writeError(w, http.StatusMethodNotAllowed, "HTTP method not allowed")
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Invalid JSON: %s", err))
+		return false
+	}
+
+	return true
+}
+
+func newID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func now() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
+
+// ensureDot returns name with a trailing dot.
+func ensureDot(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
+
+// containsFold reports whether substr is within s, ignoring case
+// and treating "*" in substr as matching anything.
+func containsFold(s, substr string) bool {
+	s, substr = strings.ToLower(s), strings.ToLower(substr)
+	for _, part := range strings.Split(substr, "*") {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+
+	return true
+}
@@ -0,0 +1,805 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldnstest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+var testWaitOptions = &vinyldns.WaitOptions{InitialInterval: time.Millisecond}
+
+func testZone(t *testing.T, s *Server) vinyldns.Zone {
+	g := s.AddGroup(vinyldns.Group{Name: "test-group", Email: "test@example.com"})
+
+	return s.AddZone(vinyldns.Zone{Name: "example.com", Email: "test@example.com", AdminGroupID: g.ID})
+}
+
+func TestZoneCreate(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	ctx := context.Background()
+
+	group, err := client.GroupCreate(&vinyldns.Group{Name: "ok-group", Email: "test@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := client.ZoneCreate(&vinyldns.Zone{Name: "ok", Email: "test@example.com", AdminGroupID: group.ID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != "Pending" || resp.Zone.Name != "ok." {
+		t.Errorf("Expected a Pending change to zone ok.; got %s %s", resp.Status, resp.Zone.Name)
+	}
+
+	change, err := client.WaitForZoneChange(ctx, resp.Zone.ID, resp.ID, testWaitOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.Status != "Synced" {
+		t.Errorf("Expected Synced; got %s", change.Status)
+	}
+
+	zone, err := client.ZoneByName("ok.")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zone.ID != resp.Zone.ID {
+		t.Errorf("Expected ZoneByName to find zone %s; got %s", resp.Zone.ID, zone.ID)
+	}
+
+	rs, err := client.RecordSets(zone.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) != 2 {
+		t.Errorf("Expected apex NS and SOA record sets; got %d record sets", len(rs))
+	}
+
+	_, err = client.ZoneCreate(&vinyldns.Zone{Name: "ok.", Email: "test@example.com", AdminGroupID: group.ID})
+	if !errors.Is(err, vinyldns.ErrConflict) {
+		t.Errorf("Expected ErrConflict creating a duplicate zone; got %v", err)
+	}
+
+	if _, err := client.Zone("missing"); !errors.Is(err, vinyldns.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound; got %v", err)
+	}
+}
+
+func TestRecordSetLifecycle(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	ctx := context.Background()
+	zone := testZone(t, s)
+
+	created, err := client.RecordSetCreate(&vinyldns.RecordSet{
+		ZoneID:  zone.ID,
+		Name:    "www",
+		Type:    "A",
+		TTL:     300,
+		Records: []vinyldns.Record{{Address: "127.0.0.1"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.Status != "Pending" {
+		t.Errorf("Expected a Pending change; got %s", created.Status)
+	}
+
+	change, err := client.WaitForRecordSetChange(ctx, zone.ID, created.RecordSet.ID, created.ChangeID, testWaitOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.Status != "Complete" {
+		t.Errorf("Expected Complete; got %s", change.Status)
+	}
+
+	rs, err := client.RecordSet(zone.ID, created.RecordSet.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs.FQDN != "www.example.com." {
+		t.Errorf("Expected FQDN www.example.com.; got %s", rs.FQDN)
+	}
+
+	_, err = client.RecordSetCreate(&vinyldns.RecordSet{ZoneID: zone.ID, Name: "www", Type: "A", TTL: 300, Records: []vinyldns.Record{{Address: "127.0.0.2"}}})
+	if !errors.Is(err, vinyldns.ErrConflict) {
+		t.Errorf("Expected ErrConflict creating a duplicate record set; got %v", err)
+	}
+
+	_, err = client.RecordSetCreate(&vinyldns.RecordSet{ZoneID: zone.ID, Name: "www", Type: "CNAME", TTL: 300, Records: []vinyldns.Record{{CName: "other.example.com."}}})
+	var vErr *vinyldns.Error
+	if !errors.As(err, &vErr) || vErr.ResponseCode != 422 {
+		t.Errorf("Expected a 422 creating a conflicting CNAME; got %v", err)
+	}
+
+	rs.TTL = 600
+	if _, err := client.RecordSetUpdate(&rs); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.RecordSetDelete(zone.ID, rs.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.RecordSet(zone.ID, rs.ID); !errors.Is(err, vinyldns.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after delete; got %v", err)
+	}
+
+	history, err := client.RecordSetChangeHistory(vinyldns.RecordSetChangeHistoryFilter{ZoneID: zone.ID, FQDN: "www.example.com.", RecordType: "A"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	types := []string{}
+	for _, c := range history.RecordSetChanges {
+		types = append(types, c.ChangeType)
+	}
+	if strings.Join(types, ",") != "Delete,Update,Create" {
+		t.Errorf("Expected Delete, Update and Create changes, newest first; got %v", types)
+	}
+}
+
+func TestPagination(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	ctx := context.Background()
+	zone := testZone(t, s)
+
+	for i := 0; i < 5; i++ {
+		_, err := client.RecordSetCreate(&vinyldns.RecordSet{ZoneID: zone.ID, Name: fmt.Sprintf("host%d", i), Type: "A", TTL: 300, Records: []vinyldns.Record{{Address: "127.0.0.1"}}})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := s.AddRecordSet(vinyldns.RecordSet{ZoneID: zone.ID, Name: "other", Type: "A", TTL: 300}); err != nil {
+		t.Fatal(err)
+	}
+
+	names := []string{}
+	for rs, err := range client.RecordSetsIter(ctx, zone.ID, vinyldns.ListFilter{NameFilter: "host", MaxItems: 2}) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, rs.Name)
+	}
+	if strings.Join(names, ",") != "host0,host1,host2,host3,host4" {
+		t.Errorf("Expected all five hosts in order; got %v", names)
+	}
+
+	changes, err := client.RecordSetChangesListAll(zone.ID, vinyldns.ListFilterRecordSetChanges{MaxItems: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 5 {
+		t.Errorf("Expected 5 record set changes; got %d", len(changes))
+	}
+
+	if _, err := client.ZonesListAll(vinyldns.ListFilter{MaxItems: 101}); err == nil {
+		t.Error("Expected error -- MaxItems must be between 1 and 100")
+	}
+}
+
+func TestZonesListAllFiltered(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	testZone(t, s)
+	g := s.AddGroup(vinyldns.Group{Name: "dns-ops", Email: "ops@example.com"})
+	s.AddZone(vinyldns.Zone{Name: "ops.example.org", Email: "ops@example.com", AdminGroupID: g.ID})
+	s.AddZone(vinyldns.Zone{Name: "2.0.192.in-addr.arpa", Email: "ops@example.com", AdminGroupID: g.ID})
+
+	zoneNames := func(f vinyldns.ZoneListFilter) string {
+		zones, err := client.ZonesListAllFiltered(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names := []string{}
+		for _, z := range zones {
+			names = append(names, z.Name)
+		}
+		return strings.Join(names, ",")
+	}
+
+	ignoreAccess, includeReverse := true, false
+	for _, tc := range []struct {
+		filter   vinyldns.ZoneListFilter
+		expected string
+	}{
+		{vinyldns.ZoneListFilter{MaxItems: 1, IgnoreAccess: &ignoreAccess}, "2.0.192.in-addr.arpa.,example.com.,ops.example.org."},
+		{vinyldns.ZoneListFilter{NameFilter: "dns-ops", SearchByAdminGroup: true}, "2.0.192.in-addr.arpa.,ops.example.org."},
+		{vinyldns.ZoneListFilter{NameFilter: "dns-ops", SearchByAdminGroup: true, IncludeReverse: &includeReverse}, "ops.example.org."},
+	} {
+		if names := zoneNames(tc.filter); names != tc.expected {
+			t.Errorf("%+v: expected %s; got %s", tc.filter, tc.expected, names)
+		}
+	}
+
+	if _, err := client.ZonesList(vinyldns.ZoneListFilter{MaxItems: 101}); err == nil {
+		t.Error("Expected error -- MaxItems must be between 1 and 100")
+	}
+}
+
+func TestRecordSetsListAllFiltered(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	zone := testZone(t, s)
+	owner := s.AddGroup(vinyldns.Group{Name: "owners", Email: "owners@example.com"})
+
+	for _, rs := range []vinyldns.RecordSet{
+		{ZoneID: zone.ID, Name: "alpha", Type: "A", TTL: 300, Records: []vinyldns.Record{{Address: "192.0.2.1"}}},
+		{ZoneID: zone.ID, Name: "bravo", Type: "AAAA", TTL: 300, Records: []vinyldns.Record{{Address: "2001:db8::1"}}, OwnerGroupID: owner.ID},
+		{ZoneID: zone.ID, Name: "charlie", Type: "A", TTL: 300, Records: []vinyldns.Record{{Address: "192.0.2.3"}}, OwnerGroupID: owner.ID},
+		{ZoneID: zone.ID, Name: "delta", Type: "TXT", TTL: 300, Records: []vinyldns.Record{{Text: "delta"}}},
+	} {
+		if _, err := s.AddRecordSet(rs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	names := func(recordSets []vinyldns.RecordSet) string {
+		n := []string{}
+		for _, rs := range recordSets {
+			n = append(n, rs.Name)
+		}
+		return strings.Join(n, ",")
+	}
+
+	for _, tc := range []struct {
+		filter   vinyldns.ZoneRecordSetFilter
+		expected string
+	}{
+		{vinyldns.ZoneRecordSetFilter{RecordTypeFilter: "A,AAAA", MaxItems: 1}, "alpha,bravo,charlie"},
+		{vinyldns.ZoneRecordSetFilter{RecordOwnerGroupFilter: owner.ID, NameSort: vinyldns.DESC}, "charlie,bravo"},
+		{vinyldns.ZoneRecordSetFilter{RecordNameFilter: "a", RecordTypeFilter: "TXT"}, "delta"},
+	} {
+		recordSets, err := client.RecordSetsListAllFiltered(zone.ID, tc.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n := names(recordSets); n != tc.expected {
+			t.Errorf("%+v: expected %s; got %s", tc.filter, tc.expected, n)
+		}
+
+		iterated := []vinyldns.RecordSet{}
+		for rs, err := range client.RecordSetsIterFiltered(context.Background(), zone.ID, tc.filter) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			iterated = append(iterated, rs)
+		}
+		if n := names(iterated); n != tc.expected {
+			t.Errorf("%+v: expected the iterator to yield %s; got %s", tc.filter, tc.expected, n)
+		}
+	}
+
+	collector, err := client.RecordSetCollectorFiltered(zone.ID, vinyldns.ZoneRecordSetFilter{RecordTypeFilter: "A", MaxItems: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	collected := []vinyldns.RecordSet{}
+	for {
+		rs, err := collector()
+		collected = append(collected, rs...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := names(collected); n != "alpha,charlie" {
+		t.Errorf("Expected the collector to return alpha,charlie; got %s", n)
+	}
+}
+
+func TestBatchRecordChange(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	ctx := context.Background()
+	zone := testZone(t, s)
+
+	resp, err := client.BatchRecordChangeCreate(&vinyldns.BatchRecordChange{
+		Changes: []vinyldns.RecordChange{
+			{ChangeType: "Add", InputName: "api.example.com.", Type: "A", TTL: 300, Record: vinyldns.RecordData{Address: "10.0.0.1"}},
+			{ChangeType: "Add", InputName: "alias.example.com.", Type: "CNAME", Record: vinyldns.RecordData{CName: "api.example.com."}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	batch, err := client.WaitForBatchChange(ctx, resp.ID, testWaitOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if batch.Status != "Complete" {
+		t.Errorf("Expected Complete; got %s", batch.Status)
+	}
+
+	rs, err := client.RecordSet(zone.ID, batch.Changes[0].RecordSetID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs.Name != "api" || rs.Records[0].Address != "10.0.0.1" {
+		t.Errorf("Expected the batch change to create api A 10.0.0.1; got %s %v", rs.Name, rs.Records)
+	}
+
+	_, err = client.BatchRecordChangeCreate(&vinyldns.BatchRecordChange{
+		Changes: []vinyldns.RecordChange{
+			{ChangeType: "Add", InputName: "api.example.com.", Type: "CNAME", Record: vinyldns.RecordData{CName: "elsewhere.example.com."}},
+			{ChangeType: "DeleteRecordSet", InputName: "missing.example.com.", Type: "A"},
+		},
+	})
+	var vErr *vinyldns.Error
+	if !errors.As(err, &vErr) || vErr.ResponseCode != 400 {
+		t.Fatalf("Expected a 400 for an invalid batch change; got %v", err)
+	}
+	var validationErr *vinyldns.BatchChangeValidationError
+	if !errors.As(err, &validationErr) || len(validationErr.Invalid()) != 2 {
+		t.Fatalf("Expected both changes to be rejected; got %v", err)
+	}
+	if msgs := validationErr.Changes[0].Errors; len(msgs) != 1 || !strings.Contains(msgs[0], "CNAME Conflict") {
+		t.Errorf("Expected a CNAME conflict; got %v", msgs)
+	}
+	if msgs := validationErr.Changes[1].Errors; len(msgs) != 1 || !strings.Contains(msgs[0], "Does Not Exist") {
+		t.Errorf("Expected the missing record set to be reported; got %v", msgs)
+	}
+
+	summaries, err := client.BatchRecordChanges()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(summaries) != 1 || summaries[0].TotalChanges != 2 {
+		t.Errorf("Expected a single batch change summary with 2 changes; got %v", summaries)
+	}
+}
+
+func TestBatchRecordChangeRecordSet(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	ctx := context.Background()
+	zone := testZone(t, s)
+
+	srv := vinyldns.RecordSet{
+		Name:     "_sip._udp",
+		ZoneName: zone.Name,
+		Type:     "SRV",
+		TTL:      300,
+		Records: []vinyldns.Record{
+			vinyldns.NewSRVRecord(0, 0, 5060, "sip1.example.com."),
+			vinyldns.NewSRVRecord(10, 5, 5060, "sip2.example.com."),
+		},
+	}
+	changes, err := vinyldns.RecordChangesFromRecordSet(srv, vinyldns.ChangeTypeAdd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.BatchRecordChangeCreate(&vinyldns.BatchRecordChange{Changes: changes})
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch, err := client.WaitForBatchChange(ctx, resp.ID, testWaitOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rs, err := client.RecordSet(zone.ID, batch.Changes[1].RecordSetID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rs.Records, srv.Records) {
+		t.Errorf("Expected the batch change to create %v; got %v", srv.Records, rs.Records)
+	}
+
+	srv.Records = srv.Records[:1]
+	changes, err = vinyldns.RecordChangesFromRecordSet(srv, vinyldns.ChangeTypeDeleteRecordSet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err = client.BatchRecordChangeCreate(&vinyldns.BatchRecordChange{Changes: changes})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.WaitForBatchChange(ctx, resp.ID, testWaitOptions); err != nil {
+		t.Fatal(err)
+	}
+
+	rs, err = client.RecordSet(zone.ID, rs.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs.Records) != 1 || rs.Records[0].Target != "sip2.example.com." {
+		t.Errorf("Expected only the sip2 record to remain; got %v", rs.Records)
+	}
+}
+
+func TestBatchRecordChangeCreateChunked(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	ctx := context.Background()
+	zone := testZone(t, s)
+	s.SetBatchChangeLimit(2)
+
+	b := vinyldns.NewBatchChangeBuilder()
+	for i := 0; i < 5; i++ {
+		b.AddRecordSet(fmt.Sprintf("host%d.example.com.", i), vinyldns.RecordTypeA, 300, vinyldns.NewARecord(fmt.Sprintf("10.0.0.%d", i)))
+	}
+	change, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.BatchRecordChangeCreate(change)
+	var vErr *vinyldns.Error
+	if !errors.As(err, &vErr) || vErr.ResponseCode != 413 {
+		t.Fatalf("Expected a 413 for a batch change over the limit; got %v", err)
+	}
+
+	result, err := client.BatchRecordChangeCreateChunked(change, &vinyldns.ChunkOptions{MaxChanges: 2, Concurrency: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids := result.IDs(); len(ids) != 3 {
+		t.Fatalf("Expected 3 batch changes; got %v", ids)
+	}
+	for _, id := range result.IDs() {
+		if _, err := client.WaitForBatchChange(ctx, id, testWaitOptions); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	recordSets, err := client.RecordSetsListAll(zone.ID, vinyldns.ListFilter{NameFilter: "host*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recordSets) != 5 {
+		t.Errorf("Expected the chunks to create 5 record sets; got %d", len(recordSets))
+	}
+}
+
+func TestBatchRecordChangeScheduled(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	zone := testZone(t, s)
+
+	change, err := vinyldns.NewBatchChangeBuilder().
+		AddRecordSet("later.example.com.", vinyldns.RecordTypeA, 300, vinyldns.NewARecord("10.0.0.1")).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	_, err = client.BatchRecordChangeSubmit(change, &vinyldns.BatchChangeCreateOptions{ScheduledTime: at})
+	if !errors.Is(err, vinyldns.ErrScheduledChangesDisabled) {
+		t.Errorf("Expected ErrScheduledChangesDisabled; got %v", err)
+	}
+
+	s.EnableScheduledChanges(true)
+	_, err = client.BatchRecordChangeSubmit(change, &vinyldns.BatchChangeCreateOptions{ScheduledTime: time.Now().Add(-time.Hour)})
+	if !errors.Is(err, vinyldns.ErrScheduledTimeInPast) {
+		t.Errorf("Expected ErrScheduledTimeInPast; got %v", err)
+	}
+
+	resp, err := client.BatchRecordChangeSubmit(change, &vinyldns.BatchChangeCreateOptions{ScheduledTime: at})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != vinyldns.BatchChangeStatusScheduled {
+		t.Errorf("Expected a Scheduled batch change; got %s", resp.Status)
+	}
+	if _, err := client.BatchRecordChangeCreate(change); err != nil {
+		t.Fatal(err)
+	}
+
+	scheduled, err := client.BatchRecordChangesScheduled(vinyldns.BatchRecordChangesFilter{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scheduled) != 1 || scheduled[0].ID != resp.ID {
+		t.Fatalf("Expected only the scheduled batch change; got %v", scheduled)
+	}
+	if got, ok := scheduled[0].ScheduledAt(); !ok || !got.Equal(at) {
+		t.Errorf("Expected it to be scheduled at %v; got %v", at, got)
+	}
+
+	_, err = client.BatchRecordChangeApprove(resp.ID, &vinyldns.BatchChangeReview{})
+	if !errors.Is(err, vinyldns.ErrScheduledChangeNotDue) {
+		t.Errorf("Expected ErrScheduledChangeNotDue; got %v", err)
+	}
+	if _, err := client.BatchRecordChangeCancel(resp.ID, &vinyldns.BatchChangeReview{}); err != nil {
+		t.Fatal(err)
+	}
+	if scheduled, _ := client.BatchRecordChangesScheduled(vinyldns.BatchRecordChangesFilter{}); len(scheduled) != 0 {
+		t.Errorf("Expected no scheduled batch changes once cancelled; got %v", scheduled)
+	}
+
+	recordSets, err := client.RecordSetsListAll(zone.ID, vinyldns.ListFilter{NameFilter: "later"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recordSets) != 1 || len(recordSets[0].Records) != 1 {
+		t.Errorf("Expected only the unscheduled batch change to be applied; got %v", recordSets)
+	}
+
+	s.HoldForReview(true)
+	allow := false
+	_, err = client.BatchRecordChangeSubmit(change, &vinyldns.BatchChangeCreateOptions{AllowManualReview: &allow})
+	var vErr *vinyldns.Error
+	if !errors.As(err, &vErr) || vErr.ResponseCode != 400 {
+		t.Errorf("Expected a 400 when manual review isn't allowed; got %v", err)
+	}
+}
+
+func TestBatchRecordChangeReview(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	zone := testZone(t, s)
+	s.HoldForReview(true)
+
+	resp, err := client.BatchRecordChangeCreate(&vinyldns.BatchRecordChange{
+		Changes: []vinyldns.RecordChange{
+			{ChangeType: "Add", InputName: "held.example.com.", Type: "A", TTL: 300, Record: vinyldns.RecordData{Address: "10.0.0.2"}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != "PendingReview" {
+		t.Errorf("Expected PendingReview; got %s", resp.Status)
+	}
+
+	approved, err := client.BatchRecordChangeApprove(resp.ID, &vinyldns.BatchChangeReview{ReviewComment: "lgtm"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approved.Status != "Complete" || approved.ApprovalStatus != "ManuallyApproved" {
+		t.Errorf("Expected a ManuallyApproved, Complete batch change; got %s %s", approved.ApprovalStatus, approved.Status)
+	}
+
+	count, err := client.RecordSetCount(zone.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count.Count != 3 {
+		t.Errorf("Expected the approved change to add a record set; got %d record sets", count.Count)
+	}
+
+	if _, err := client.BatchRecordChangeCancel(resp.ID, nil); err == nil {
+		t.Error("Expected an error cancelling a batch change that is not pending review")
+	}
+}
+
+func TestBatchRecordChangeRollback(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	ctx := context.Background()
+	zone := testZone(t, s)
+
+	submit := func(change *vinyldns.BatchRecordChange, err error) string {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := client.BatchRecordChangeCreate(change)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.WaitForBatchChange(ctx, resp.ID, testWaitOptions); err != nil {
+			t.Fatal(err)
+		}
+		return resp.ID
+	}
+	records := func() map[string]vinyldns.RecordSet {
+		t.Helper()
+		recordSets, err := client.RecordSetsListAll(zone.ID, vinyldns.ListFilter{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		byName := map[string]vinyldns.RecordSet{}
+		for _, rs := range recordSets {
+			byName[rs.Name+" "+rs.Type] = rs
+		}
+		return byName
+	}
+
+	submit(vinyldns.NewBatchChangeBuilder().
+		AddRecordSet("txt.example.com.", vinyldns.RecordTypeTXT, 120, vinyldns.NewTXTRecord("old1"), vinyldns.NewTXTRecord("old2")).
+		AddRecordSet("mail.example.com.", vinyldns.RecordTypeMX, 600, vinyldns.NewMXRecord(10, "mx1.example.com."), vinyldns.NewMXRecord(20, "mx2.example.com.")).
+		Build())
+	before := records()
+
+	id := submit(vinyldns.NewBatchChangeBuilder().
+		AddRecordSet("api.example.com.", vinyldns.RecordTypeA, 300, vinyldns.NewARecord("10.0.0.1")).
+		DeleteRecordSet("txt.example.com.", vinyldns.RecordTypeTXT).
+		AddRecordSet("txt.example.com.", vinyldns.RecordTypeTXT, 60, vinyldns.NewTXTRecord("new")).
+		DeleteRecordSetEntry("mail.example.com.", vinyldns.RecordTypeMX, vinyldns.NewMXRecord(20, "mx2.example.com.")).
+		Build())
+
+	plan, err := client.BatchRecordChangeRollbackPlan(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Change.Changes) != 5 {
+		t.Errorf("Expected 5 inverse changes; got:\n%s", plan)
+	}
+
+	resp, err := client.BatchRecordChangeRollback(plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batch, err := client.WaitForBatchChange(ctx, resp.ID, testWaitOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if batch.Status != vinyldns.BatchChangeStatusComplete {
+		t.Fatalf("Expected the rollback to complete; got %s", batch.Status)
+	}
+
+	after := records()
+	if len(after) != len(before) {
+		t.Errorf("Expected the record sets %v; got %v", before, after)
+	}
+	for key, rs := range before {
+		got := after[key]
+		slices.SortFunc(got.Records, func(a, b vinyldns.Record) int { return strings.Compare(a.Text+a.Exchange, b.Text+b.Exchange) })
+		if got.TTL != rs.TTL || !reflect.DeepEqual(got.Records, rs.Records) {
+			t.Errorf("Expected %s to be restored to %v (ttl %d); got %v (ttl %d)", key, rs.Records, rs.TTL, got.Records, got.TTL)
+		}
+	}
+}
+
+func TestBatchRecordChangesListAll(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	testZone(t, s)
+	g := s.AddGroup(vinyldns.Group{Name: "reviewers", Email: "reviewers@example.com"})
+
+	create := func(name string, ownerGroupID string) {
+		_, err := client.BatchRecordChangeCreate(&vinyldns.BatchRecordChange{
+			OwnerGroupID: ownerGroupID,
+			Changes: []vinyldns.RecordChange{
+				{ChangeType: "Add", InputName: name + ".example.com.", Type: "A", TTL: 300, Record: vinyldns.RecordData{Address: "10.0.0.1"}},
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	create("applied", "")
+	s.HoldForReview(true)
+	for i := 0; i < 3; i++ {
+		create(fmt.Sprintf("held%d", i), g.ID)
+	}
+
+	pending, err := client.BatchRecordChangesListAll(vinyldns.BatchRecordChangesFilter{
+		MaxItems:       2,
+		ApprovalStatus: vinyldns.ApprovalStatusPendingReview,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 3 {
+		t.Fatalf("Expected 3 batch changes pending review; got %d", len(pending))
+	}
+	for _, summary := range pending {
+		if summary.TotalChanges != 1 || summary.OwnerGroupName != "reviewers" {
+			t.Errorf("Expected a summary of 1 change owned by reviewers; got %+v", summary)
+		}
+	}
+
+	page, err := client.BatchRecordChangesList(vinyldns.BatchRecordChangesFilter{GroupName: "reviewers", MaxItems: 1, StartFrom: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(page.BatchChanges) != 1 || page.NextID != 0 {
+		t.Errorf("Expected the last page of reviewers' batch changes; got %+v", page)
+	}
+
+	future, err := client.BatchRecordChangesListAll(vinyldns.BatchRecordChangesFilter{DateTimeRangeStart: time.Now().Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(future) != 0 {
+		t.Errorf("Expected no batch changes created in the future; got %d", len(future))
+	}
+
+	if _, err := client.BatchRecordChangesList(vinyldns.BatchRecordChangesFilter{MaxItems: 101}); err == nil {
+		t.Error("Expected error -- MaxItems must be between 1 and 100")
+	}
+}
+
+func TestGroupDeleteAdminOfZone(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	zone := testZone(t, s)
+
+	if _, err := client.GroupDelete(zone.AdminGroupID); err == nil {
+		t.Error("Expected an error deleting the admin group of a zone")
+	}
+
+	if _, err := client.ZoneDelete(zone.ID); err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := client.GroupDelete(zone.AdminGroupID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted.Status != "Deleted" {
+		t.Errorf("Expected Deleted; got %s", deleted.Status)
+	}
+
+	if _, err := client.Group(zone.AdminGroupID); !errors.Is(err, vinyldns.ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after delete; got %v", err)
+	}
+}
+
+func TestRecordSetsPlanApply(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	client := s.Client()
+	zone := testZone(t, s)
+
+	for _, rs := range []vinyldns.RecordSet{
+		vinyldns.NewRecordSet(zone.ID, "www", vinyldns.RecordTypeCNAME, 300, vinyldns.NewCNAMERecord("lb.example.com.")),
+		vinyldns.NewRecordSet(zone.ID, "old", vinyldns.RecordTypeTXT, 300, vinyldns.NewTXTRecord("old")),
+	} {
+		if _, err := s.AddRecordSet(rs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	desired := []vinyldns.RecordSet{
+		vinyldns.NewRecordSet("", "www", vinyldns.RecordTypeA, 300, vinyldns.NewARecord("10.0.0.1")),
+	}
+	plan, err := client.RecordSetsPlan(zone.ID, desired, vinyldns.PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	responses, err := client.RecordSetsApply(plan, testWaitOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(responses) != 3 {
+		t.Errorf("Expected 2 deletes and a create; got %d responses", len(responses))
+	}
+
+	plan, err = client.RecordSetsPlan(zone.ID, desired, vinyldns.PlanOptions{Prune: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if plan.HasChanges() {
+		t.Errorf("Expected no changes after applying the plan; got:\n%s", plan)
+	}
+}
@@ -0,0 +1,452 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldnstest
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/vinyldns/go-vinyldns/vinyldns"
+)
+
+// AddZone adds a zone directly to the server's state, along with
+// the apex SOA and NS record sets a zone sync would discover.
+func (s *Server) AddZone(z vinyldns.Zone) vinyldns.Zone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.addZone(z).zone
+}
+
+type zoneCreated struct {
+	zone   vinyldns.Zone
+	change vinyldns.ZoneChange
+}
+
+func (s *Server) addZone(z vinyldns.Zone) zoneCreated {
+	z.Name = ensureDot(z.Name)
+	if z.ID == "" {
+		z.ID = newID()
+	}
+	z.Status = "Active"
+	z.Created = now()
+	z.LatestSync = z.Created
+	z.Account = "system"
+	z.AccessLevel = "Delete"
+	if z.ACL == nil {
+		z.ACL = &vinyldns.ZoneACL{Rules: []vinyldns.ACLRule{}}
+	}
+	s.zones[z.ID] = &z
+
+	change := s.recordZoneChange(z, "Create")
+
+	for _, rs := range apexRecordSets(z) {
+		s.putRecordSet(&rs)
+	}
+
+	return zoneCreated{z, change}
+}
+
+func apexRecordSets(z vinyldns.Zone) []vinyldns.RecordSet {
+	return []vinyldns.RecordSet{
+		{
+			ZoneID: z.ID,
+			Name:   z.Name,
+			Type:   "SOA",
+			TTL:    38400,
+			Records: []vinyldns.Record{{
+				MName:   "ns1.parent.com.",
+				RName:   strings.Replace(ensureDot(z.Email), "@", ".", 1),
+				Serial:  1,
+				Refresh: 10800,
+				Retry:   3600,
+				Expire:  604800,
+				Minimum: 38400,
+			}},
+		},
+		{
+			ZoneID:  z.ID,
+			Name:    z.Name,
+			Type:    "NS",
+			TTL:     38400,
+			Records: []vinyldns.Record{{NSDName: "ns1.parent.com."}},
+		},
+	}
+}
+
+func (s *Server) recordZoneChange(z vinyldns.Zone, changeType string) vinyldns.ZoneChange {
+	change := vinyldns.ZoneChange{
+		Zone:       z,
+		UserID:     DefaultUserID,
+		ChangeType: changeType,
+		Status:     "Synced",
+		Created:    now(),
+		ID:         newID(),
+	}
+	s.zoneChanges[z.ID] = append([]vinyldns.ZoneChange{change}, s.zoneChanges[z.ID]...)
+
+	return change
+}
+
+func (s *Server) zoneUpdateResponse(change vinyldns.ZoneChange) vinyldns.ZoneUpdateResponse {
+	zone := change.Zone
+	zone.Status = "Pending"
+
+	return vinyldns.ZoneUpdateResponse{
+		Zone:       zone,
+		UserID:     change.UserID,
+		ChangeType: change.ChangeType,
+		Status:     "Pending",
+		Created:    change.Created,
+		ID:         change.ID,
+	}
+}
+
+func (s *Server) routeZones(w http.ResponseWriter, r *http.Request, segs []string) {
+	if len(segs) == 0 {
+		switch r.Method {
+		case http.MethodGet:
+			s.listZones(w, r)
+		case http.MethodPost:
+			s.createZone(w, r)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	switch segs[0] {
+	case "batchrecordchanges":
+		s.routeBatchChanges(w, r, segs[1:])
+		return
+	case "backendids":
+		writeJSON(w, http.StatusOK, []string{"func-test-backend"})
+		return
+	case "name":
+		if len(segs) == 2 && r.Method == http.MethodGet {
+			s.zoneByName(w, segs[1])
+			return
+		}
+		notFound(w, r)
+		return
+	case "deleted":
+		if len(segs) == 2 && segs[1] == "changes" && r.Method == http.MethodGet {
+			s.listDeletedZones(w, r)
+			return
+		}
+		notFound(w, r)
+		return
+	}
+
+	zone, ok := s.zones[segs[0]]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Zone with id %s does not exists", segs[0]))
+		return
+	}
+
+	if len(segs) == 1 {
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, vinyldns.ZoneResponse{Zone: *zone})
+		case http.MethodPut:
+			s.updateZone(w, r, zone)
+		case http.MethodDelete:
+			s.deleteZone(w, zone)
+		default:
+			methodNotAllowed(w)
+		}
+		return
+	}
+
+	switch segs[1] {
+	case "details":
+		s.zoneDetails(w, zone)
+	case "changes":
+		s.listZoneChanges(w, r, zone)
+	case "sync":
+		if r.Method != http.MethodPost {
+			methodNotAllowed(w)
+			return
+		}
+		zone.LatestSync = now()
+		change := s.recordZoneChange(*zone, "Sync")
+		resp := change
+		resp.Status = "Pending"
+		writeJSON(w, http.StatusAccepted, resp)
+	case "acl":
+		if len(segs) == 3 && segs[2] == "rules" {
+			s.updateZoneACL(w, r, zone)
+			return
+		}
+		notFound(w, r)
+	case "recordsets":
+		s.routeRecordSets(w, r, zone, segs[2:])
+	case "recordsetchanges":
+		s.listRecordSetChanges(w, r, zone)
+	case "recordsetcount":
+		count := 0
+		for _, rs := range s.recordSets {
+			if rs.ZoneID == zone.ID {
+				count++
+			}
+		}
+		writeJSON(w, http.StatusOK, vinyldns.RecordSetCount{Count: count})
+	default:
+		notFound(w, r)
+	}
+}
+
+func (s *Server) listZones(w http.ResponseWriter, r *http.Request) {
+	p, err := parsePage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	q := r.URL.Query()
+	nameFilter := q.Get("nameFilter")
+	byAdminGroup := q.Get("searchByAdminGroup") == "true"
+	includeReverse := q.Get("includeReverse") != "false"
+
+	// Every zone is accessible to the fake's single user, so ignoreAccess has no effect.
+	zones := []vinyldns.Zone{}
+	for _, z := range s.zones {
+		name := z.Name
+		if byAdminGroup {
+			name = ""
+			if g, ok := s.groups[z.AdminGroupID]; ok {
+				name = g.Name
+			}
+		}
+		if nameFilter != "" && !containsFold(name, nameFilter) {
+			continue
+		}
+		if !includeReverse && isReverseZone(z.Name) {
+			continue
+		}
+		zones = append(zones, *z)
+	}
+	sort.Slice(zones, func(i, j int) bool { return zones[i].Name < zones[j].Name })
+
+	lo, hi, next := p.bounds(len(zones))
+	writeJSON(w, http.StatusOK, vinyldns.Zones{
+		Zones:     zones[lo:hi],
+		StartFrom: cursor(p.start),
+		MaxItems:  p.maxItems,
+		NextID:    cursor(next),
+	})
+}
+
+func isReverseZone(name string) bool {
+	return strings.HasSuffix(name, ".in-addr.arpa.") || strings.HasSuffix(name, ".ip6.arpa.")
+}
+
+func (s *Server) createZone(w http.ResponseWriter, r *http.Request) {
+	z := vinyldns.Zone{}
+	if !decode(w, r, &z) {
+		return
+	}
+
+	if z.Name == "" || z.Email == "" || z.AdminGroupID == "" {
+		writeError(w, http.StatusBadRequest, "Zone name, email and adminGroupId are required")
+		return
+	}
+	if _, ok := s.groups[z.AdminGroupID]; !ok {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("Admin group with ID %s does not exist", z.AdminGroupID))
+		return
+	}
+	if s.findZoneByName(z.Name) != nil {
+		writeError(w, http.StatusConflict, fmt.Sprintf("Zone with name %s already exists.", ensureDot(z.Name)))
+		return
+	}
+
+	z.ID = ""
+	created := s.addZone(z)
+	writeJSON(w, http.StatusAccepted, s.zoneUpdateResponse(created.change))
+}
+
+func (s *Server) updateZone(w http.ResponseWriter, r *http.Request, zone *vinyldns.Zone) {
+	z := vinyldns.Zone{}
+	if !decode(w, r, &z) {
+		return
+	}
+
+	if z.Email != "" {
+		zone.Email = z.Email
+	}
+	if z.AdminGroupID != "" {
+		zone.AdminGroupID = z.AdminGroupID
+	}
+	if z.ACL != nil {
+		zone.ACL = z.ACL
+	}
+	zone.Connection = z.Connection
+	zone.TransferConnection = z.TransferConnection
+	zone.Shared = z.Shared
+	zone.BackendID = z.BackendID
+	zone.Updated = now()
+
+	change := s.recordZoneChange(*zone, "Update")
+	writeJSON(w, http.StatusAccepted, s.zoneUpdateResponse(change))
+}
+
+func (s *Server) deleteZone(w http.ResponseWriter, zone *vinyldns.Zone) {
+	delete(s.zones, zone.ID)
+	for id, rs := range s.recordSets {
+		if rs.ZoneID == zone.ID {
+			delete(s.recordSets, id)
+		}
+	}
+
+	deleted := *zone
+	deleted.Status = "Deleted"
+	change := s.recordZoneChange(deleted, "Delete")
+	s.deletedZones = append([]vinyldns.ZoneChange{change}, s.deletedZones...)
+
+	writeJSON(w, http.StatusAccepted, s.zoneUpdateResponse(change))
+}
+
+func (s *Server) zoneByName(w http.ResponseWriter, name string) {
+	zone := s.findZoneByName(name)
+	if zone == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Zone with name %s does not exists", name))
+		return
+	}
+
+	writeJSON(w, http.StatusOK, vinyldns.ZoneResponse{Zone: *zone})
+}
+
+func (s *Server) findZoneByName(name string) *vinyldns.Zone {
+	name = strings.ToLower(ensureDot(name))
+	for _, z := range s.zones {
+		if strings.ToLower(z.Name) == name {
+			return z
+		}
+	}
+
+	return nil
+}
+
+func (s *Server) zoneDetails(w http.ResponseWriter, zone *vinyldns.Zone) {
+	details := vinyldns.ZoneDetails{
+		Name:         zone.Name,
+		Email:        zone.Email,
+		Status:       zone.Status,
+		AdminGroupID: zone.AdminGroupID,
+	}
+	if g, ok := s.groups[zone.AdminGroupID]; ok {
+		details.AdminGroupName = g.Name
+	}
+
+	writeJSON(w, http.StatusOK, vinyldns.ZoneDetailsResponse{ZoneDetails: details})
+}
+
+func (s *Server) listZoneChanges(w http.ResponseWriter, r *http.Request, zone *vinyldns.Zone) {
+	p, err := parsePage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	changes := s.zoneChanges[zone.ID]
+	lo, hi, next := p.bounds(len(changes))
+	writeJSON(w, http.StatusOK, vinyldns.ZoneChanges{
+		ZoneID:      zone.ID,
+		ZoneChanges: changes[lo:hi],
+		StartFrom:   cursor(p.start),
+		MaxItems:    p.maxItems,
+		NextID:      cursor(next),
+	})
+}
+
+func (s *Server) listDeletedZones(w http.ResponseWriter, r *http.Request) {
+	p, err := parsePage(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	nameFilter := r.URL.Query().Get("nameFilter")
+
+	infos := []vinyldns.DeletedZoneInfo{}
+	for _, change := range s.deletedZones {
+		if nameFilter != "" && !containsFold(change.Zone.Name, nameFilter) {
+			continue
+		}
+		info := vinyldns.DeletedZoneInfo{ZoneChange: change, UserName: change.UserID, AccessLevel: "Delete"}
+		if g, ok := s.groups[change.Zone.AdminGroupID]; ok {
+			info.AdminGroupName = g.Name
+		}
+		infos = append(infos, info)
+	}
+
+	lo, hi, next := p.bounds(len(infos))
+	writeJSON(w, http.StatusOK, vinyldns.DeletedZonesResponse{
+		ZonesDeletedInfo: infos[lo:hi],
+		StartFrom:        cursor(p.start),
+		MaxItems:         p.maxItems,
+		NextID:           cursor(next),
+		IgnoreAccess:     r.URL.Query().Get("ignoreAccess") == "true",
+	})
+}
+
+func (s *Server) updateZoneACL(w http.ResponseWriter, r *http.Request, zone *vinyldns.Zone) {
+	rule := vinyldns.ACLRule{}
+	if !decode(w, r, &rule) {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		zone.ACL.Rules = append(zone.ACL.Rules, rule)
+	case http.MethodDelete:
+		rules := []vinyldns.ACLRule{}
+		for _, existing := range zone.ACL.Rules {
+			if !sameACLRule(existing, rule) {
+				rules = append(rules, existing)
+			}
+		}
+		zone.ACL.Rules = rules
+	default:
+		methodNotAllowed(w)
+		return
+	}
+
+	change := s.recordZoneChange(*zone, "Update")
+	writeJSON(w, http.StatusAccepted, s.zoneUpdateResponse(change))
+}
+
+func sameACLRule(a, b vinyldns.ACLRule) bool {
+	return a.AccessLevel == b.AccessLevel &&
+		a.UserID == b.UserID &&
+		a.GroupID == b.GroupID &&
+		a.RecordMask == b.RecordMask &&
+		strings.Join(a.RecordTypes, ",") == strings.Join(b.RecordTypes, ",")
+}
+
+// zoneForFQDN returns the zone with the longest name that the FQDN it's passed falls within.
+func (s *Server) zoneForFQDN(fqdn string) *vinyldns.Zone {
+	fqdn = strings.ToLower(ensureDot(fqdn))
+
+	var best *vinyldns.Zone
+	for _, z := range s.zones {
+		name := strings.ToLower(z.Name)
+		if fqdn == name || strings.HasSuffix(fqdn, "."+name) {
+			if best == nil || len(z.Name) > len(best.Name) {
+				best = z
+			}
+		}
+	}
+
+	return best
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// WaitOptions configures how the WaitFor helpers poll for a change's status.
+type WaitOptions struct {
+	// InitialInterval is the delay before the first re-poll.
+	// It doubles after every poll, up to MaxInterval.
+	InitialInterval time.Duration
+
+	// MaxInterval caps the delay between polls.
+	MaxInterval time.Duration
+}
+
+func (o *WaitOptions) intervals() (time.Duration, time.Duration) {
+	initial, max := 500*time.Millisecond, 10*time.Second
+	if o != nil && o.InitialInterval > 0 {
+		initial = o.InitialInterval
+	}
+	if o != nil && o.MaxInterval > 0 {
+		max = o.MaxInterval
+	}
+	if max < initial {
+		max = initial
+	}
+
+	return initial, max
+}
+
+// zoneChangeSearchPages is the number of pages of a zone's most recent
+// changes, of 100 changes each, searched for the change WaitForZoneChange awaits.
+const zoneChangeSearchPages = 2
+
+// ErrZoneChangeNotFound is returned by WaitForZoneChange when the change
+// it awaits isn't among the zone's most recent changes by the time ctx is done.
+var ErrZoneChangeNotFound = errors.New("vinyldns: zone change not found")
+
+// ChangeFailedError is returned by the WaitFor helpers when
+// a change reaches a failed terminal status.
+type ChangeFailedError struct {
+	// Kind is the kind of change: "RecordSetChange", "ZoneChange" or "BatchChange".
+	Kind          string
+	ID            string
+	Status        string
+	SystemMessage string
+}
+
+func (e *ChangeFailedError) Error() string {
+	msg := fmt.Sprintf("%s %s finished with status %s", e.Kind, e.ID, e.Status)
+	if e.SystemMessage != "" {
+		msg = msg + ": " + e.SystemMessage
+	}
+
+	return msg
+}
+
+// WaitForRecordSetChange polls the record set change matching the Zone, RecordSet and
+// Change IDs it's passed until it is Complete or Failed, or until ctx is done.
+// A Failed change is returned along with a *ChangeFailedError.
+func (c *Client) WaitForRecordSetChange(ctx context.Context, zoneID, recordSetID, changeID string, opts *WaitOptions) (*RecordSetChange, error) {
+	var change *RecordSetChange
+	err := poll(ctx, opts, func() (bool, error) {
+		var err error
+		change, err = c.RecordSetChangeWithContext(ctx, zoneID, recordSetID, changeID)
+		if err != nil {
+			return false, err
+		}
+
+		switch change.Status {
+		case "Complete":
+			return true, nil
+		case "Failed":
+			return true, &ChangeFailedError{Kind: "RecordSetChange", ID: change.ID, Status: change.Status, SystemMessage: change.SystemMessage}
+		}
+
+		return false, nil
+	})
+
+	return change, err
+}
+
+// WaitForZoneChange polls the zone change matching the Zone and ZoneChange IDs it's passed
+// until it is Complete, Synced or Failed, or until ctx is done.
+// A Failed change is returned along with a *ChangeFailedError. Only the zone's
+// most recent changes are searched; if the change isn't among them by the time
+// ctx is done, the error also matches ErrZoneChangeNotFound.
+func (c *Client) WaitForZoneChange(ctx context.Context, zoneID, zoneChangeID string, opts *WaitOptions) (*ZoneChange, error) {
+	var (
+		change   *ZoneChange
+		notFound error
+	)
+	err := poll(ctx, opts, func() (bool, error) {
+		found, err := c.findZoneChange(ctx, zoneID, zoneChangeID)
+		if errors.Is(err, ErrZoneChangeNotFound) {
+			// The change may not have been recorded yet.
+			notFound = err
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		change, notFound = found, nil
+
+		switch change.Status {
+		case "Complete", "Synced":
+			return true, nil
+		case "Failed":
+			return true, &ChangeFailedError{Kind: "ZoneChange", ID: change.ID, Status: change.Status, SystemMessage: change.SystemMessage}
+		}
+
+		return false, nil
+	})
+	if err != nil && notFound != nil {
+		err = errors.Join(notFound, err)
+	}
+
+	return change, err
+}
+
+// findZoneChange searches the zone's most recent changes, which are listed
+// newest first, for the one whose ID it's passed. Only zoneChangeSearchPages
+// pages are searched, so that polling a busy zone doesn't walk its whole history;
+// an error matching ErrZoneChangeNotFound is returned if the change isn't among them.
+func (c *Client) findZoneChange(ctx context.Context, zoneID, zoneChangeID string) (*ZoneChange, error) {
+	filter := ListFilter{MaxItems: 100}
+	for page := 0; page < zoneChangeSearchPages; page++ {
+		resp, err := c.zoneChangesList(ctx, zoneID, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, zc := range resp.ZoneChanges {
+			if zc.ID == zoneChangeID {
+				return &zc, nil
+			}
+		}
+		if resp.NextID == "" {
+			break
+		}
+		filter.StartFrom = resp.NextID
+	}
+
+	return nil, fmt.Errorf("%w: %s isn't among the most recent changes of zone %s", ErrZoneChangeNotFound, zoneChangeID, zoneID)
+}
+
+// WaitForBatchChange polls the batch change whose ID it's passed until it leaves
+// processing, or until ctx is done. Complete, PendingReview and Scheduled batch changes
+// are returned without error; Failed, PartialFailure, Rejected and Cancelled ones are
+// returned along with a *ChangeFailedError whose SystemMessage collects the messages
+// of the batch's failed single changes.
+func (c *Client) WaitForBatchChange(ctx context.Context, changeID string, opts *WaitOptions) (*BatchRecordChange, error) {
+	var change *BatchRecordChange
+	err := poll(ctx, opts, func() (bool, error) {
+		var err error
+		change, err = c.BatchRecordChangeWithContext(ctx, changeID)
+		if err != nil {
+			return false, err
+		}
+
+		switch change.Status {
+		case BatchChangeStatusComplete, BatchChangeStatusPendingReview, BatchChangeStatusScheduled:
+			return true, nil
+		case BatchChangeStatusFailed, BatchChangeStatusPartialFailure, BatchChangeStatusRejected, BatchChangeStatusCancelled:
+			return true, &ChangeFailedError{Kind: "BatchChange", ID: change.ID, Status: change.Status, SystemMessage: batchChangeSystemMessage(change)}
+		}
+
+		return false, nil
+	})
+
+	return change, err
+}
+
+func batchChangeSystemMessage(change *BatchRecordChange) string {
+	msgs := []string{}
+	for _, rc := range change.Changes {
+		if rc.SystemMessage != "" {
+			msgs = append(msgs, fmt.Sprintf("%s %s: %s", rc.InputName, rc.Type, rc.SystemMessage))
+		}
+	}
+	if len(msgs) == 0 && change.ReviewComment != "" {
+		msgs = append(msgs, change.ReviewComment)
+	}
+
+	return strings.Join(msgs, "; ")
+}
+
+// poll calls check until it reports done or returns an error,
+// sleeping with exponential backoff in between.
+func poll(ctx context.Context, opts *WaitOptions, check func() (bool, error)) error {
+	interval, max := opts.intervals()
+
+	for {
+		done, err := check()
+		if done || err != nil {
+			return err
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return err
+		}
+
+		interval *= 2
+		if interval > max {
+			interval = max
+		}
+	}
+}
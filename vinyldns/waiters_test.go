@@ -0,0 +1,175 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+var testWaitOptions = &WaitOptions{InitialInterval: time.Millisecond, MaxInterval: 2 * time.Millisecond}
+
+// sequenceServer responds to requests for path with each body in turn,
+// repeating the last one once the sequence is exhausted.
+func sequenceServer(t *testing.T, path string, bodies ...string) (*httptest.Server, *Client) {
+	calls := 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			t.Errorf("Unexpected request for %s", r.URL.Path)
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		body := bodies[len(bodies)-1]
+		if calls < len(bodies) {
+			body = bodies[calls]
+		}
+		calls++
+		fmt.Fprint(w, body)
+	}))
+
+	return ts, NewClient(ClientConfiguration{Host: ts.URL})
+}
+
+func TestWaitForRecordSetChange(t *testing.T) {
+	server, client := sequenceServer(t, "/zones/z1/recordsets/rs1/changes/c1",
+		`{"id":"c1","status":"Pending"}`,
+		`{"id":"c1","status":"Pending"}`,
+		`{"id":"c1","status":"Complete"}`,
+	)
+	defer server.Close()
+
+	change, err := client.WaitForRecordSetChange(context.Background(), "z1", "rs1", "c1", testWaitOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.Status != "Complete" {
+		t.Errorf("Expected Complete; got %s", change.Status)
+	}
+}
+
+func TestWaitForRecordSetChangeFailed(t *testing.T) {
+	server, client := sequenceServer(t, "/zones/z1/recordsets/rs1/changes/c1",
+		`{"id":"c1","status":"Failed","systemMessage":"Failed validating update to DNS"}`,
+	)
+	defer server.Close()
+
+	change, err := client.WaitForRecordSetChange(context.Background(), "z1", "rs1", "c1", testWaitOptions)
+
+	var failed *ChangeFailedError
+	if !errors.As(err, &failed) {
+		t.Fatalf("Expected a *ChangeFailedError; got %v", err)
+	}
+	if failed.SystemMessage != "Failed validating update to DNS" {
+		t.Errorf("Expected SystemMessage to be carried; got %q", failed.SystemMessage)
+	}
+	if change == nil || change.Status != "Failed" {
+		t.Error("Expected the failed change to be returned")
+	}
+}
+
+func TestWaitForRecordSetChangeDeadline(t *testing.T) {
+	server, client := sequenceServer(t, "/zones/z1/recordsets/rs1/changes/c1",
+		`{"id":"c1","status":"Pending"}`,
+	)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.WaitForRecordSetChange(ctx, "z1", "rs1", "c1", testWaitOptions)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected context.DeadlineExceeded; got %v", err)
+	}
+}
+
+func TestWaitForZoneChange(t *testing.T) {
+	server, client := sequenceServer(t, "/zones/z1/changes",
+		`{"zoneId":"z1","zoneChanges":[{"id":"other","status":"Synced"}]}`,
+		`{"zoneId":"z1","zoneChanges":[{"id":"zc1","status":"Pending"}]}`,
+		`{"zoneId":"z1","zoneChanges":[{"id":"zc1","status":"Synced"}]}`,
+	)
+	defer server.Close()
+
+	change, err := client.WaitForZoneChange(context.Background(), "z1", "zc1", testWaitOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.Status != "Synced" {
+		t.Errorf("Expected Synced; got %s", change.Status)
+	}
+}
+
+func TestWaitForZoneChangeNotFound(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("startFrom") {
+		case "":
+			fmt.Fprint(w, `{"zoneId":"z1","zoneChanges":[{"id":"newer"}],"nextId":"p2"}`)
+		case "p2":
+			fmt.Fprint(w, `{"zoneId":"z1","zoneChanges":[{"id":"older"}],"nextId":"p3"}`)
+		default:
+			t.Errorf("Expected only the most recent pages to be searched; got startFrom=%s", r.URL.Query().Get("startFrom"))
+			http.Error(w, "not found", http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	client := NewClient(ClientConfiguration{Host: ts.URL})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := client.WaitForZoneChange(ctx, "z1", "zc1", testWaitOptions)
+	if !errors.Is(err, ErrZoneChangeNotFound) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected ErrZoneChangeNotFound and context.DeadlineExceeded; got %v", err)
+	}
+}
+
+func TestWaitForBatchChange(t *testing.T) {
+	server, client := sequenceServer(t, "/zones/batchrecordchanges/b1",
+		`{"id":"b1","status":"PendingProcessing"}`,
+		`{"id":"b1","status":"PendingReview"}`,
+	)
+	defer server.Close()
+
+	change, err := client.WaitForBatchChange(context.Background(), "b1", testWaitOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.Status != "PendingReview" {
+		t.Errorf("Expected PendingReview; got %s", change.Status)
+	}
+}
+
+func TestWaitForBatchChangeFailed(t *testing.T) {
+	server, client := sequenceServer(t, "/zones/batchrecordchanges/b1",
+		`{"id":"b1","status":"PartialFailure","changes":[
+			{"inputName":"ok.ok.","type":"A","status":"Complete"},
+			{"inputName":"bad.ok.","type":"A","status":"Failed","systemMessage":"DNS backend refused"}
+		]}`,
+	)
+	defer server.Close()
+
+	_, err := client.WaitForBatchChange(context.Background(), "b1", testWaitOptions)
+
+	var failed *ChangeFailedError
+	if !errors.As(err, &failed) {
+		t.Fatalf("Expected a *ChangeFailedError; got %v", err)
+	}
+	if failed.SystemMessage != "bad.ok. A: DNS backend refused" {
+		t.Errorf("Unexpected SystemMessage %q", failed.SystemMessage)
+	}
+}
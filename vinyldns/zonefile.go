@@ -0,0 +1,319 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxCharacterString is the maximum length of an RFC 1035 <character-string>.
+const maxCharacterString = 255
+
+// ZoneFileExport writes the zone whose ID it's passed, along with all
+// its record sets, to w as an RFC 1035 master file. See WriteZoneFile.
+func (c *Client) ZoneFileExport(zoneID string, w io.Writer) error {
+	return c.ZoneFileExportWithContext(context.Background(), zoneID, w)
+}
+
+// ZoneFileExportWithContext writes the zone whose ID it's passed, along with all
+// its record sets, to w as an RFC 1035 master file, using ctx for the lifetime of
+// the requests. See WriteZoneFile.
+func (c *Client) ZoneFileExportWithContext(ctx context.Context, zoneID string, w io.Writer) error {
+	zone, err := c.ZoneWithContext(ctx, zoneID)
+	if err != nil {
+		return err
+	}
+
+	recordSets, err := c.RecordSetsListAllWithContext(ctx, zoneID, ListFilter{})
+	if err != nil {
+		return err
+	}
+
+	return WriteZoneFile(w, zone, recordSets)
+}
+
+// WriteZoneFile writes the zone and record sets it's passed to w as a canonical
+// RFC 1035 master file: an $ORIGIN and $TTL directive, followed by the SOA record,
+// the apex NS records, and then the remaining records sorted by name and type.
+// Names are written relative to the origin, and TTLs are omitted where they
+// match the $TTL, which is the SOA record set's TTL.
+//
+// Output is deterministic, so exports of an unchanged zone are identical.
+func WriteZoneFile(w io.Writer, zone Zone, recordSets []RecordSet) error {
+	origin := absoluteName(zone.Name)
+	sorted := sortedForZoneFile(origin, recordSets)
+	defaultTTL := zoneFileDefaultTTL(sorted)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "$ORIGIN %s\n", origin)
+	fmt.Fprintf(bw, "$TTL %d\n", defaultTTL)
+
+	for _, rs := range sorted {
+		owner := relativeOwner(rs.Name, origin)
+		ttl := ""
+		if rs.TTL != defaultTTL {
+			ttl = strconv.Itoa(rs.TTL)
+		}
+
+		lines := []string{}
+		for _, r := range rs.Records {
+			rdata, err := FormatRecordData(RecordType(rs.Type), r)
+			if err != nil {
+				return fmt.Errorf("record set %s %s: %w", rs.Name, rs.Type, err)
+			}
+			lines = append(lines, rdata)
+		}
+		sort.Strings(lines)
+
+		for _, rdata := range lines {
+			fmt.Fprintf(bw, "%s\t%s\tIN\t%s\t%s\n", owner, ttl, rs.Type, rdata)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// FormatRecordData returns the master file presentation of the RDATA of the record
+// it's passed, as a record of the type it's passed.
+func FormatRecordData(recordType RecordType, r Record) (string, error) {
+	switch recordType {
+	case RecordTypeA, RecordTypeAAAA:
+		return r.Address, nil
+	case RecordTypeCNAME:
+		return absoluteName(r.CName), nil
+	case RecordTypeDS:
+		return fmt.Sprintf("%d %d %d %s", r.KeyTag, r.Algorithm, r.DigestType, r.Digest), nil
+	case RecordTypeMX:
+		return fmt.Sprintf("%d %s", r.Preference, absoluteName(r.Exchange)), nil
+	case RecordTypeNAPTR:
+		return fmt.Sprintf("%d %d %s %s %s %s", r.Order, r.Preference, quoteString(r.Flags), quoteString(r.Service), quoteString(r.Regexp), absoluteName(r.Replacement)), nil
+	case RecordTypeNS:
+		return absoluteName(r.NSDName), nil
+	case RecordTypePTR:
+		return absoluteName(r.PTRDName), nil
+	case RecordTypeSOA:
+		return fmt.Sprintf("%s %s %d %d %d %d %d", absoluteName(r.MName), absoluteName(r.RName), r.Serial, r.Refresh, r.Retry, r.Expire, r.Minimum), nil
+	case RecordTypeSPF, RecordTypeTXT:
+		return quoteText(r.Text), nil
+	case RecordTypeSRV:
+		return fmt.Sprintf("%d %d %d %s", r.Priority, r.Weight, r.Port, absoluteName(r.Target)), nil
+	case RecordTypeSSHFP:
+		return fmt.Sprintf("%d %d %s", r.Algorithm, r.FingerprintType, r.Fingerprint), nil
+	}
+
+	return "", fmt.Errorf("unsupported record type %q", recordType)
+}
+
+// sortedForZoneFile returns a copy of recordSets with the SOA first,
+// then the apex NS, then the rest ordered by name and type.
+func sortedForZoneFile(origin string, recordSets []RecordSet) []RecordSet {
+	rank := func(rs RecordSet) int {
+		apex := relativeOwner(rs.Name, origin) == "@"
+		switch {
+		case rs.Type == string(RecordTypeSOA):
+			return 0
+		case apex && rs.Type == string(RecordTypeNS):
+			return 1
+		case apex:
+			return 2
+		}
+		return 3
+	}
+
+	sorted := append([]RecordSet{}, recordSets...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		ri, rj := rank(sorted[i]), rank(sorted[j])
+		if ri != rj {
+			return ri < rj
+		}
+		ni, nj := strings.ToLower(relativeOwner(sorted[i].Name, origin)), strings.ToLower(relativeOwner(sorted[j].Name, origin))
+		if ni != nj {
+			return ni < nj
+		}
+		return sorted[i].Type < sorted[j].Type
+	})
+
+	return sorted
+}
+
+// zoneFileDefaultTTL returns the SOA record set's TTL or,
+// in the absence of an SOA, the first record set's TTL.
+func zoneFileDefaultTTL(sorted []RecordSet) int {
+	if len(sorted) == 0 {
+		return 0
+	}
+
+	return sorted[0].TTL
+}
+
+// relativeOwner returns the master file owner name of
+// the record set name it's passed, relative to origin.
+func relativeOwner(name, origin string) string {
+	if name == "" || name == "@" || strings.EqualFold(absoluteName(name), origin) {
+		return "@"
+	}
+	if !strings.HasSuffix(name, ".") {
+		return name
+	}
+	if suffix := "." + origin; len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return name[:len(name)-len(suffix)]
+	}
+
+	return name
+}
+
+// absoluteName returns name with a trailing dot.
+func absoluteName(name string) string {
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
+
+// quoteText returns text as one or more quoted <character-string>s,
+// splitting it into chunks of at most 255 bytes.
+func quoteText(text string) string {
+	if len(text) <= maxCharacterString {
+		return quoteString(text)
+	}
+
+	chunks := []string{}
+	for len(text) > maxCharacterString {
+		chunks = append(chunks, quoteString(text[:maxCharacterString]))
+		text = text[maxCharacterString:]
+	}
+	chunks = append(chunks, quoteString(text))
+
+	return strings.Join(chunks, " ")
+}
+
+// quoteString returns s as a quoted <character-string>, escaping quotes
+// and backslashes, and non-printable bytes as \DDD.
+func quoteString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c >= 0x7f:
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// RecordSetImportFailure is a record set that RecordSetsImport failed to create.
+type RecordSetImportFailure struct {
+	RecordSet RecordSet
+	Err       error
+}
+
+// RecordSetsImportResult reports the outcome of a RecordSetsImport.
+type RecordSetsImportResult struct {
+	// Created holds the responses to the record sets created.
+	Created []RecordSetUpdateResponse
+
+	// Skipped holds the SOA and apex NS record sets, which VinylDNS manages itself.
+	Skipped []RecordSet
+
+	// Failed holds the record sets whose creation failed.
+	Failed []RecordSetImportFailure
+}
+
+// ZoneFileImport parses the RFC 1035 master file read from r and creates its
+// record sets in the zone whose ID it's passed. If opts.Origin is empty, the
+// zone's name is used. See ParseZoneFile and RecordSetsImport.
+func (c *Client) ZoneFileImport(zoneID string, r io.Reader, opts ZoneFileParseOptions) (*RecordSetsImportResult, error) {
+	return c.ZoneFileImportWithContext(context.Background(), zoneID, r, opts)
+}
+
+// ZoneFileImportWithContext parses the RFC 1035 master file read from r and creates
+// its record sets in the zone whose ID it's passed, using ctx for the lifetime of the
+// requests. If opts.Origin is empty, the zone's name is used.
+// See ParseZoneFile and RecordSetsImport.
+func (c *Client) ZoneFileImportWithContext(ctx context.Context, zoneID string, r io.Reader, opts ZoneFileParseOptions) (*RecordSetsImportResult, error) {
+	zone, err := c.ZoneWithContext(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Origin == "" {
+		opts.Origin = zone.Name
+	}
+
+	recordSets, err := ParseZoneFile(r, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.recordSetsImport(ctx, zone, recordSets)
+}
+
+// RecordSetsImport creates the record sets it's passed in the zone whose ID it's
+// passed, skipping SOA and apex NS record sets. The failure to create a record set
+// doesn't stop the import; it's reported in the result's Failed record sets.
+func (c *Client) RecordSetsImport(zoneID string, recordSets []RecordSet) (*RecordSetsImportResult, error) {
+	return c.RecordSetsImportWithContext(context.Background(), zoneID, recordSets)
+}
+
+// RecordSetsImportWithContext creates the record sets it's passed in the zone whose ID
+// it's passed, skipping SOA and apex NS record sets, using ctx for the lifetime of the
+// requests. The failure to create a record set doesn't stop the import; it's reported
+// in the result's Failed record sets.
+func (c *Client) RecordSetsImportWithContext(ctx context.Context, zoneID string, recordSets []RecordSet) (*RecordSetsImportResult, error) {
+	zone, err := c.ZoneWithContext(ctx, zoneID)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.recordSetsImport(ctx, zone, recordSets)
+}
+
+func (c *Client) recordSetsImport(ctx context.Context, zone Zone, recordSets []RecordSet) (*RecordSetsImportResult, error) {
+	result := &RecordSetsImportResult{}
+	origin := absoluteName(zone.Name)
+
+	for _, rs := range recordSets {
+		apex := relativeOwner(rs.Name, origin) == "@"
+		if rs.Type == string(RecordTypeSOA) || apex && rs.Type == string(RecordTypeNS) {
+			result.Skipped = append(result.Skipped, rs)
+			continue
+		}
+
+		rs.ZoneID = zone.ID
+		resp, err := c.RecordSetCreateWithContext(ctx, &rs)
+		if err != nil {
+			if ctx.Err() != nil {
+				return result, ctx.Err()
+			}
+			result.Failed = append(result.Failed, RecordSetImportFailure{RecordSet: rs, Err: err})
+			continue
+		}
+		result.Created = append(result.Created, *resp)
+	}
+
+	return result, nil
+}
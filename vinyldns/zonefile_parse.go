@@ -0,0 +1,567 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// maxIncludeDepth bounds $INCLUDE nesting, guarding against include loops.
+const maxIncludeDepth = 10
+
+// ZoneFileParseOptions configures ParseZoneFile.
+type ZoneFileParseOptions struct {
+	// Origin is the zone name. It is the initial $ORIGIN, and
+	// the name record set names are made relative to.
+	Origin string
+
+	// DefaultTTL is the TTL of records that precede any $TTL directive
+	// and have no TTL of their own. If 0, such records are an error.
+	DefaultTTL int
+
+	// Includes resolves the file names of $INCLUDE directives.
+	// If nil, $INCLUDE directives are an error.
+	Includes fs.FS
+}
+
+// ZoneFileError is returned by ParseZoneFile for a malformed zone file.
+type ZoneFileError struct {
+	File string
+	Line int
+	Err  error
+}
+
+func (e *ZoneFileError) Error() string {
+	if e.File != "" {
+		return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Err)
+	}
+
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+func (e *ZoneFileError) Unwrap() error {
+	return e.Err
+}
+
+// ParseZoneFile parses the RFC 1035 master file read from r into record sets,
+// grouping records by name and type in the order each name and type first appears.
+// It supports the $ORIGIN, $TTL and $INCLUDE directives, parenthesized multi-line
+// records, comments, relative and omitted owner names, and "@".
+//
+// Record set names are relative to opts.Origin, except at the apex,
+// where the name is opts.Origin itself, as VinylDNS represents it.
+func ParseZoneFile(r io.Reader, opts ZoneFileParseOptions) ([]RecordSet, error) {
+	if opts.Origin == "" {
+		return nil, fmt.Errorf("ZoneFileParseOptions.Origin is required")
+	}
+
+	p := &zoneFileParser{
+		zone:    absoluteName(opts.Origin),
+		ttl:     opts.DefaultTTL,
+		fsys:    opts.Includes,
+		indexes: map[string]int{},
+	}
+	if err := p.parse(r, "", absoluteName(opts.Origin), 0); err != nil {
+		return nil, err
+	}
+
+	return p.recordSets, nil
+}
+
+type zoneFileParser struct {
+	zone       string
+	ttl        int
+	fsys       fs.FS
+	owner      string
+	recordSets []RecordSet
+	indexes    map[string]int
+}
+
+// zoneFileToken is a single field of a zone file entry.
+type zoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneFileEntry is a logical line of a zone file, which may span
+// physical lines within parentheses.
+type zoneFileEntry struct {
+	line       int
+	tokens     []zoneFileToken
+	blankOwner bool
+}
+
+func (p *zoneFileParser) parse(r io.Reader, file, origin string, depth int) error {
+	entries, err := lexZoneFile(r)
+	if err != nil {
+		if zfErr, ok := err.(*ZoneFileError); ok {
+			zfErr.File = file
+		}
+		return err
+	}
+
+	for _, e := range entries {
+		fail := func(err error) error {
+			return &ZoneFileError{File: file, Line: e.line, Err: err}
+		}
+
+		if !e.blankOwner && !e.tokens[0].quoted && strings.HasPrefix(e.tokens[0].text, "$") {
+			switch strings.ToUpper(e.tokens[0].text) {
+			case "$ORIGIN":
+				if len(e.tokens) != 2 {
+					return fail(fmt.Errorf("$ORIGIN requires a single domain name"))
+				}
+				origin = qualifyName(e.tokens[1].text, origin)
+			case "$TTL":
+				if len(e.tokens) != 2 {
+					return fail(fmt.Errorf("$TTL requires a single TTL"))
+				}
+				ttl, err := parseTTL(e.tokens[1].text)
+				if err != nil {
+					return fail(err)
+				}
+				p.ttl = ttl
+			case "$INCLUDE":
+				if err := p.include(e, origin, depth); err != nil {
+					if _, ok := err.(*ZoneFileError); ok {
+						return err
+					}
+					return fail(err)
+				}
+			default:
+				return fail(fmt.Errorf("unsupported directive %s", e.tokens[0].text))
+			}
+			continue
+		}
+
+		if err := p.record(e, origin); err != nil {
+			return fail(err)
+		}
+	}
+
+	return nil
+}
+
+func (p *zoneFileParser) include(e zoneFileEntry, origin string, depth int) error {
+	if len(e.tokens) < 2 || len(e.tokens) > 3 {
+		return fmt.Errorf("$INCLUDE requires a file name and an optional domain name")
+	}
+	if p.fsys == nil {
+		return fmt.Errorf("$INCLUDE is not supported without ZoneFileParseOptions.Includes")
+	}
+	if depth >= maxIncludeDepth {
+		return fmt.Errorf("$INCLUDE nested more than %d deep", maxIncludeDepth)
+	}
+
+	name := e.tokens[1].text
+	f, err := p.fsys.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	includeOrigin := origin
+	if len(e.tokens) == 3 {
+		includeOrigin = qualifyName(e.tokens[2].text, origin)
+	}
+
+	// The included file's owner and $ORIGIN don't carry over to the including file.
+	owner := p.owner
+	err = p.parse(f, name, includeOrigin, depth+1)
+	p.owner = owner
+
+	return err
+}
+
+func (p *zoneFileParser) record(e zoneFileEntry, origin string) error {
+	tokens := e.tokens
+	if !e.blankOwner {
+		p.owner = qualifyName(tokens[0].text, origin)
+		tokens = tokens[1:]
+	}
+	if p.owner == "" {
+		return fmt.Errorf("record has no owner name")
+	}
+
+	ttl := p.ttl
+	var recordType RecordType
+	for recordType == "" {
+		if len(tokens) == 0 {
+			return fmt.Errorf("record has no type")
+		}
+		tok := tokens[0]
+		field := tok.text
+		tokens = tokens[1:]
+
+		if t, err := parseTTL(field); err == nil && !tok.quoted {
+			ttl = t
+			continue
+		}
+		switch strings.ToUpper(field) {
+		case "IN":
+			continue
+		case "CH", "HS", "CS":
+			return fmt.Errorf("unsupported class %s", field)
+		}
+
+		t, err := ParseRecordType(field)
+		if err != nil {
+			return err
+		}
+		recordType = t
+	}
+	if ttl == 0 {
+		return fmt.Errorf("record has no TTL and no $TTL or DefaultTTL applies")
+	}
+
+	record, err := parseRecordData(recordType, tokens, origin)
+	if err != nil {
+		return fmt.Errorf("%s record: %w", recordType, err)
+	}
+
+	name, err := p.relativeName(p.owner)
+	if err != nil {
+		return err
+	}
+
+	key := strings.ToLower(name) + " " + string(recordType)
+	if i, ok := p.indexes[key]; ok {
+		p.recordSets[i].Records = append(p.recordSets[i].Records, record)
+		return nil
+	}
+	p.indexes[key] = len(p.recordSets)
+	p.recordSets = append(p.recordSets, NewRecordSet("", name, recordType, ttl, record))
+
+	return nil
+}
+
+// relativeName returns the record set name of the absolute owner name it's passed.
+func (p *zoneFileParser) relativeName(owner string) (string, error) {
+	if strings.EqualFold(owner, p.zone) {
+		return p.zone, nil
+	}
+
+	suffix := "." + p.zone
+	if len(owner) > len(suffix) && strings.EqualFold(owner[len(owner)-len(suffix):], suffix) {
+		return owner[:len(owner)-len(suffix)], nil
+	}
+
+	return "", fmt.Errorf("owner name %s is outside of zone %s", owner, p.zone)
+}
+
+// parseRecordData parses the RDATA fields of a record of the type it's passed.
+func parseRecordData(recordType RecordType, tokens []zoneFileToken, origin string) (Record, error) {
+	fields := make([]string, len(tokens))
+	for i, t := range tokens {
+		fields[i] = t.text
+	}
+
+	want := map[RecordType]int{
+		RecordTypeA:     1,
+		RecordTypeAAAA:  1,
+		RecordTypeCNAME: 1,
+		RecordTypeMX:    2,
+		RecordTypeNAPTR: 6,
+		RecordTypeNS:    1,
+		RecordTypePTR:   1,
+		RecordTypeSOA:   7,
+		RecordTypeSRV:   4,
+	}[recordType]
+	if want != 0 && len(fields) != want {
+		return Record{}, fmt.Errorf("expected %d fields; got %d", want, len(fields))
+	}
+
+	ints := func(fs ...string) ([]int, error) {
+		out := make([]int, len(fs))
+		for i, f := range fs {
+			n, err := strconv.Atoi(f)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q", f)
+			}
+			out[i] = n
+		}
+		return out, nil
+	}
+
+	switch recordType {
+	case RecordTypeA:
+		if ip := net.ParseIP(fields[0]); ip == nil || ip.To4() == nil {
+			return Record{}, fmt.Errorf("invalid IPv4 address %q", fields[0])
+		}
+		return NewARecord(fields[0]), nil
+	case RecordTypeAAAA:
+		if ip := net.ParseIP(fields[0]); ip == nil || ip.To4() != nil {
+			return Record{}, fmt.Errorf("invalid IPv6 address %q", fields[0])
+		}
+		return NewAAAARecord(fields[0]), nil
+	case RecordTypeCNAME:
+		return NewCNAMERecord(qualifyName(fields[0], origin)), nil
+	case RecordTypeNS:
+		return NewNSRecord(qualifyName(fields[0], origin)), nil
+	case RecordTypePTR:
+		return NewPTRRecord(qualifyName(fields[0], origin)), nil
+	case RecordTypeMX:
+		n, err := ints(fields[0])
+		if err != nil {
+			return Record{}, err
+		}
+		return NewMXRecord(n[0], qualifyName(fields[1], origin)), nil
+	case RecordTypeSOA:
+		serial, err := ints(fields[2])
+		if err != nil {
+			return Record{}, err
+		}
+		timers := make([]int, 4)
+		for i, f := range fields[3:] {
+			if timers[i], err = parseTTL(f); err != nil {
+				return Record{}, err
+			}
+		}
+		return NewSOARecord(qualifyName(fields[0], origin), qualifyName(fields[1], origin), serial[0], timers[0], timers[1], timers[2], timers[3]), nil
+	case RecordTypeSRV:
+		n, err := ints(fields[:3]...)
+		if err != nil {
+			return Record{}, err
+		}
+		return NewSRVRecord(n[0], n[1], n[2], qualifyName(fields[3], origin)), nil
+	case RecordTypeNAPTR:
+		n, err := ints(fields[:2]...)
+		if err != nil {
+			return Record{}, err
+		}
+		return NewNAPTRRecord(n[0], n[1], fields[2], fields[3], fields[4], qualifyName(fields[5], origin)), nil
+	case RecordTypeTXT, RecordTypeSPF:
+		if len(fields) == 0 {
+			return Record{}, fmt.Errorf("expected at least one character string")
+		}
+		return Record{Text: joinText(tokens)}, nil
+	case RecordTypeSSHFP:
+		if len(fields) < 3 {
+			return Record{}, fmt.Errorf("expected 3 fields; got %d", len(fields))
+		}
+		n, err := ints(fields[:2]...)
+		if err != nil {
+			return Record{}, err
+		}
+		return NewSSHFPRecord(n[0], n[1], strings.Join(fields[2:], "")), nil
+	case RecordTypeDS:
+		if len(fields) < 4 {
+			return Record{}, fmt.Errorf("expected 4 fields; got %d", len(fields))
+		}
+		n, err := ints(fields[:3]...)
+		if err != nil {
+			return Record{}, err
+		}
+		return NewDSRecord(n[0], n[1], n[2], strings.Join(fields[3:], "")), nil
+	}
+
+	return Record{}, fmt.Errorf("unsupported record type %q", recordType)
+}
+
+// joinText returns the text of TXT or SPF RDATA tokens. Quoted strings are
+// concatenated, as a long text is split into several of them, while an unquoted
+// token is separated by a space from its neighbours, so that unquoted words
+// keep the spaces between them.
+func joinText(tokens []zoneFileToken) string {
+	var b strings.Builder
+	for i, t := range tokens {
+		if i > 0 && !(t.quoted && tokens[i-1].quoted) {
+			b.WriteByte(' ')
+		}
+		b.WriteString(t.text)
+	}
+
+	return b.String()
+}
+
+// qualifyName returns the absolute form of the
+// domain name it's passed, relative to origin.
+func qualifyName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	case origin == ".":
+		return name + "."
+	}
+
+	return name + "." + origin
+}
+
+// parseTTL parses a TTL given in seconds or with BIND's
+// w, d, h, m and s unit suffixes, such as 1h30m.
+func parseTTL(s string) (int, error) {
+	if s != "" && isDigits(s) {
+		return strconv.Atoi(s)
+	}
+
+	units := map[rune]int{'w': 604800, 'd': 86400, 'h': 3600, 'm': 60, 's': 1}
+	total, n, digits := 0, 0, false
+	for _, c := range strings.ToLower(s) {
+		if c >= '0' && c <= '9' {
+			n = n*10 + int(c-'0')
+			digits = true
+			continue
+		}
+		unit, ok := units[c]
+		if !ok || !digits {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		total, n, digits = total+n*unit, 0, false
+	}
+	if s == "" || digits {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+
+	return total, nil
+}
+
+// lexZoneFile splits a zone file into logical entries of tokens,
+// removing comments and joining lines within parentheses.
+func lexZoneFile(r io.Reader) ([]zoneFileEntry, error) {
+	entries := []zoneFileEntry{}
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	var current *zoneFileEntry
+	depth, lineNo := 0, 0
+
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+
+		if depth == 0 {
+			current = &zoneFileEntry{line: lineNo, blankOwner: line != "" && (line[0] == ' ' || line[0] == '\t')}
+		}
+
+		for i := 0; i < len(line); {
+			c := line[i]
+			switch {
+			case c == ';':
+				i = len(line)
+			case c == ' ' || c == '\t' || c == '\r':
+				i++
+			case c == '(':
+				depth++
+				i++
+			case c == ')':
+				if depth == 0 {
+					return nil, &ZoneFileError{Line: lineNo, Err: fmt.Errorf("unbalanced )")}
+				}
+				depth--
+				i++
+			case c == '"':
+				text, n, err := unquote(line[i+1:])
+				if err != nil {
+					return nil, &ZoneFileError{Line: lineNo, Err: err}
+				}
+				current.tokens = append(current.tokens, zoneFileToken{text: text, quoted: true})
+				i += n + 1
+			default:
+				j := i
+				for j < len(line) && !strings.ContainsRune(" \t\r;()\"", rune(line[j])) {
+					if line[j] == '\\' {
+						j++
+					}
+					j++
+				}
+				if j > len(line) {
+					j = len(line)
+				}
+				text, err := unescape(line[i:j])
+				if err != nil {
+					return nil, &ZoneFileError{Line: lineNo, Err: err}
+				}
+				current.tokens = append(current.tokens, zoneFileToken{text: text})
+				i = j
+			}
+		}
+
+		if depth == 0 && len(current.tokens) != 0 {
+			entries = append(entries, *current)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, &ZoneFileError{Line: lineNo, Err: fmt.Errorf("unbalanced (")}
+	}
+
+	return entries, nil
+}
+
+// unquote reads a quoted string whose opening quote has been consumed,
+// returning its unescaped text and the number of bytes read, including
+// the closing quote.
+func unquote(s string) (string, int, error) {
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			i++
+		case '"':
+			text, err := unescape(s[:i])
+			return text, i + 1, err
+		}
+	}
+
+	return "", 0, fmt.Errorf("unterminated quoted string")
+}
+
+// unescape resolves the \X and \DDD escapes of a zone file field.
+func unescape(s string) (string, error) {
+	if !strings.Contains(s, "\\") {
+		return s, nil
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+4 <= len(s) && isDigits(s[i+1:i+4]) {
+			n, _ := strconv.Atoi(s[i+1 : i+4])
+			if n > 255 {
+				return "", fmt.Errorf("invalid escape \\%s", s[i+1:i+4])
+			}
+			b.WriteByte(byte(n))
+			i += 3
+			continue
+		}
+		if i+1 >= len(s) {
+			return "", fmt.Errorf("trailing backslash")
+		}
+		i++
+		b.WriteByte(s[i])
+	}
+
+	return b.String(), nil
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	zoneFile := `$ORIGIN ok.
+$TTL 1h
+@	IN	SOA	ns1.parent.com. hostmaster.parent.com. (
+		1439234395 ; serial
+		3h         ; refresh
+		1h         ; retry
+		1w         ; expire
+		38400 )    ; minimum
+	IN	NS	ns1.parent.com.
+	300	IN	MX	10 mail
+www	IN	300	A	10.0.0.1
+WWW		A	10.0.0.2
+txt		TXT	"v=spf1 include:\"quoted\" -all" " ~all"
+words		TXT	hello world
+$ORIGIN sub.ok.
+host	60	CNAME	www.ok.
+`
+
+	got, err := ParseZoneFile(strings.NewReader(zoneFile), ZoneFileParseOptions{Origin: "ok"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []RecordSet{
+		NewRecordSet("", "ok.", RecordTypeSOA, 3600, NewSOARecord("ns1.parent.com.", "hostmaster.parent.com.", 1439234395, 10800, 3600, 604800, 38400)),
+		NewRecordSet("", "ok.", RecordTypeNS, 3600, NewNSRecord("ns1.parent.com.")),
+		NewRecordSet("", "ok.", RecordTypeMX, 300, NewMXRecord(10, "mail.ok.")),
+		NewRecordSet("", "www", RecordTypeA, 300, NewARecord("10.0.0.1"), NewARecord("10.0.0.2")),
+		NewRecordSet("", "txt", RecordTypeTXT, 3600, NewTXTRecord(`v=spf1 include:"quoted" -all ~all`)),
+		NewRecordSet("", "words", RecordTypeTXT, 3600, NewTXTRecord("hello world")),
+		NewRecordSet("", "host.sub", RecordTypeCNAME, 60, NewCNAMERecord("www.ok.")),
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Unexpected record sets:\n%+v\nexpected:\n%+v", got, expected)
+	}
+}
+
+func TestParseZoneFileInclude(t *testing.T) {
+	fsys := fstest.MapFS{
+		"hosts.zone": {Data: []byte("www A 10.0.0.1\n")},
+	}
+	zoneFile := "$TTL 300\n$INCLUDE hosts.zone dev.ok.\napi A 10.0.0.2\n"
+
+	got, err := ParseZoneFile(strings.NewReader(zoneFile), ZoneFileParseOptions{Origin: "ok.", Includes: fsys})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 2 || got[0].Name != "www.dev" || got[1].Name != "api" {
+		t.Errorf("Expected www.dev from the include and api after it; got %+v", got)
+	}
+
+	_, err = ParseZoneFile(strings.NewReader(zoneFile), ZoneFileParseOptions{Origin: "ok."})
+	if err == nil {
+		t.Error("Expected an error for $INCLUDE without Includes")
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	tests := []struct {
+		zoneFile string
+		line     int
+	}{
+		{"$TTL 300\nwww A 10.0.0.1\nwww A not-an-ip\n", 3},
+		{"www A 10.0.0.1\n", 1},
+		{"$TTL 300\nwww.other. A 10.0.0.1\n", 2},
+		{"$TTL 300\n\nwww HINFO a b\n", 3},
+		{"$TTL 300\n@ SOA ns1. host. ( 1 2 3 4 5\n", 2},
+		{"$TTL 300\nmx MX ten mail\n", 2},
+	}
+
+	for _, test := range tests {
+		_, err := ParseZoneFile(strings.NewReader(test.zoneFile), ZoneFileParseOptions{Origin: "ok."})
+		var zfErr *ZoneFileError
+		if !errors.As(err, &zfErr) {
+			t.Errorf("Expected a ZoneFileError for %q; got %v", test.zoneFile, err)
+			continue
+		}
+		if zfErr.Line != test.line {
+			t.Errorf("Expected an error on line %d for %q; got %v", test.line, test.zoneFile, err)
+		}
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	for s, want := range map[string]int{"300": 300, "1h30m": 5400, "1W": 604800, "2d": 172800} {
+		got, err := parseTTL(s)
+		if err != nil || got != want {
+			t.Errorf("Expected %s to parse as %d; got %d, %v", s, want, got, err)
+		}
+	}
+	for _, s := range []string{"", "h", "1x", "10m5"} {
+		if _, err := parseTTL(s); err == nil {
+			t.Errorf("Expected an error parsing %q", s)
+		}
+	}
+}
+
+func TestParseZoneFileRoundTrip(t *testing.T) {
+	sets := []RecordSet{
+		NewRecordSet("", "ok.", RecordTypeSOA, 38400, NewSOARecord("ns1.parent.com.", "hostmaster.parent.com.", 1, 10800, 3600, 604800, 38400)),
+		NewRecordSet("", "ok.", RecordTypeNS, 38400, NewNSRecord("ns1.parent.com.")),
+		NewRecordSet("", "_sip._tcp", RecordTypeSRV, 300, NewSRVRecord(0, 0, 5060, "sip.ok.")),
+		NewRecordSet("", "ds", RecordTypeDS, 300, NewDSRecord(60485, 5, 1, "2BB183AF5F22588179A53B0A98631FAD1A292118")),
+		NewRecordSet("", "long", RecordTypeTXT, 38400, NewTXTRecord(strings.Repeat("a", 300)+"\x01")),
+		NewRecordSet("", "naptr", RecordTypeNAPTR, 300, NewNAPTRRecord(100, 10, "U", "E2U+sip", "!^.*$!sip:info@ok!", ".")),
+		NewRecordSet("", "sshfp", RecordTypeSSHFP, 300, NewSSHFPRecord(2, 1, "123456789ABCDEF67890123456789ABCDEF67890")),
+	}
+
+	var buf bytes.Buffer
+	if err := WriteZoneFile(&buf, Zone{Name: "ok."}, sets); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := ParseZoneFile(&buf, ZoneFileParseOptions{Origin: "ok."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, sets) {
+		t.Errorf("Expected record sets to survive a round trip:\n%+v\ngot:\n%+v", sets, got)
+	}
+}
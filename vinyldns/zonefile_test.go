@@ -0,0 +1,133 @@
+/*
+Copyright 2018-2026 Comcast Cable Communications Management, LLC
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at http://www.apache.org/licenses/LICENSE-2.0
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vinyldns
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestZoneFileExport(t *testing.T) {
+	zoneJSON, err := readFile("test-fixtures/zones/zone.json")
+	if err != nil {
+		t.Error(err)
+	}
+	recordSetsJSON, err := readFile("test-fixtures/recordsets/recordsets-zone-file.json")
+	if err != nil {
+		t.Error(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/123",
+			code:     200,
+			body:     zoneJSON,
+		},
+		{
+			endpoint: "http://host.com/zones/123/recordsets",
+			code:     200,
+			body:     recordSetsJSON,
+		},
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	if err := client.ZoneFileExport("123", &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := strings.Join([]string{
+		"$ORIGIN vinyldns.",
+		"$TTL 38400",
+		"@\t\tIN\tSOA\tns1.parent.com. hostmaster.parent.com. 1439234395 10800 3600 604800 38400",
+		"@\t\tIN\tNS\tns1.parent.com.",
+		"@\t3600\tIN\tMX\t10 mail.vinyldns.",
+		"_sip._tcp\t\tIN\tSRV\t0 5 5060 sip.vinyldns.",
+		"txt\t\tIN\tTXT\t\"v=spf1 include:\\\"quoted\\\" -all\"",
+		"www\t300\tIN\tA\t10.0.0.1",
+		"www\t300\tIN\tA\t10.0.0.2",
+		"",
+	}, "\n")
+	if buf.String() != expected {
+		t.Errorf("Unexpected zone file:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestWriteZoneFileSplitsLongText(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	rs := NewRecordSet("123", "long", RecordTypeTXT, 300, NewTXTRecord(long))
+
+	var buf bytes.Buffer
+	if err := WriteZoneFile(&buf, Zone{Name: "ok"}, []RecordSet{rs}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := "\"" + strings.Repeat("a", 255) + "\" \"" + strings.Repeat("a", 45) + "\""
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("Expected TXT to be split into 255 byte strings; got %s", buf.String())
+	}
+	if !strings.HasPrefix(buf.String(), "$ORIGIN ok.\n$TTL 300\n") {
+		t.Errorf("Expected $ORIGIN and $TTL directives; got %s", buf.String())
+	}
+}
+
+func TestFormatRecordDataUnsupported(t *testing.T) {
+	if _, err := FormatRecordData("HINFO", Record{}); err == nil {
+		t.Error("Expected an error formatting an unsupported record type")
+	}
+}
+
+func TestZoneFileImport(t *testing.T) {
+	zoneJSON, err := readFile("test-fixtures/zones/zone.json")
+	if err != nil {
+		t.Error(err)
+	}
+	recordSetJSON, err := readFile("test-fixtures/recordsets/recordset-update.json")
+	if err != nil {
+		t.Error(err)
+	}
+	server, client := testTools([]testToolsConfig{
+		{
+			endpoint: "http://host.com/zones/123",
+			code:     200,
+			body:     zoneJSON,
+		},
+		{
+			endpoint: "http://host.com/zones/123/recordsets",
+			code:     202,
+			body:     recordSetJSON,
+		},
+	})
+	defer server.Close()
+
+	zoneFile := strings.Join([]string{
+		"$TTL 300",
+		"@ IN SOA ns1.parent.com. hostmaster.parent.com. 1 10800 3600 604800 38400",
+		"@ IN NS ns1.parent.com.",
+		"sub IN NS ns1.sub.vinyldns.",
+		"www IN A 10.0.0.1",
+		"",
+	}, "\n")
+
+	result, err := client.ZoneFileImport("123", strings.NewReader(zoneFile), ZoneFileParseOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(result.Skipped) != 2 || result.Skipped[0].Type != "SOA" || result.Skipped[1].Type != "NS" {
+		t.Errorf("Expected the SOA and apex NS to be skipped; got %+v", result.Skipped)
+	}
+	if len(result.Created) != 2 || len(result.Failed) != 0 {
+		t.Errorf("Expected 2 record sets created and none failed; got %+v", result)
+	}
+}
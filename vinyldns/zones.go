@@ -13,15 +13,23 @@ limitations under the License.
 package vinyldns
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"net/http"
+	"iter"
 )
 
 // Zones retrieves the list of zones a user has access to.
 func (c *Client) Zones() ([]Zone, error) {
+	return c.ZonesWithContext(context.Background())
+}
+
+// ZonesWithContext retrieves the list of zones a user has access to,
+// using ctx for the lifetime of the request.
+func (c *Client) ZonesWithContext(ctx context.Context) ([]Zone, error) {
 	zones := &Zones{}
-	err := resourceRequest(c, zonesEP(c), "GET", nil, zones)
+	err := resourceRequest(ctx, c, zonesEP(c), "GET", nil, zones)
 	if err != nil {
 		return []Zone{}, err
 	}
@@ -29,9 +37,43 @@ func (c *Client) Zones() ([]Zone, error) {
 	return zones.Zones, nil
 }
 
+// ZonesList retrieves a page of the zones matching the ZoneListFilter criteria passed.
+func (c *Client) ZonesList(filter ZoneListFilter) (*Zones, error) {
+	return c.ZonesListWithContext(context.Background(), filter)
+}
+
+// ZonesListWithContext retrieves a page of the zones matching the ZoneListFilter
+// criteria passed, using ctx for the lifetime of the request.
+func (c *Client) ZonesListWithContext(ctx context.Context, filter ZoneListFilter) (*Zones, error) {
+	if filter.MaxItems > 100 {
+		return nil, fmt.Errorf("MaxItems must be between 1 and 100")
+	}
+
+	return c.zonesList(ctx, filter)
+}
+
 // ZonesListAll retrieves the complete list of zones with the ListFilter criteria passed.
 // Handles paging through results on the user's behalf.
 func (c *Client) ZonesListAll(filter ListFilter) ([]Zone, error) {
+	return c.ZonesListAllWithContext(context.Background(), filter)
+}
+
+// ZonesListAllWithContext is ZonesListAll with a context.Context
+// that is applied to each page request.
+func (c *Client) ZonesListAllWithContext(ctx context.Context, filter ListFilter) ([]Zone, error) {
+	return c.ZonesListAllFilteredWithContext(ctx, zoneListFilter(filter))
+}
+
+// ZonesListAllFiltered retrieves the complete list of zones with the ZoneListFilter
+// criteria passed, such as every zone in the system when IgnoreAccess is set.
+// Handles paging through results on the user's behalf.
+func (c *Client) ZonesListAllFiltered(filter ZoneListFilter) ([]Zone, error) {
+	return c.ZonesListAllFilteredWithContext(context.Background(), filter)
+}
+
+// ZonesListAllFilteredWithContext is ZonesListAllFiltered with a context.Context
+// that is applied to each page request.
+func (c *Client) ZonesListAllFilteredWithContext(ctx context.Context, filter ZoneListFilter) ([]Zone, error) {
 	if filter.MaxItems > 100 {
 		return nil, fmt.Errorf("MaxItems must be between 1 and 100")
 	}
@@ -39,7 +81,7 @@ func (c *Client) ZonesListAll(filter ListFilter) ([]Zone, error) {
 	zones := []Zone{}
 
 	for {
-		resp, err := c.zonesList(filter)
+		resp, err := c.zonesList(ctx, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -53,10 +95,49 @@ func (c *Client) ZonesListAll(filter ListFilter) ([]Zone, error) {
 	}
 }
 
+// ZonesPager returns a Pager over the zones matching the ListFilter criteria
+// passed, fetching pages lazily starting at filter.StartFrom.
+func (c *Client) ZonesPager(ctx context.Context, filter ListFilter) *Pager[Zone, string] {
+	return c.ZonesPagerFiltered(ctx, zoneListFilter(filter))
+}
+
+// ZonesIter returns an iterator over the zones matching the ListFilter criteria passed.
+func (c *Client) ZonesIter(ctx context.Context, filter ListFilter) iter.Seq2[Zone, error] {
+	return c.ZonesPager(ctx, filter).All()
+}
+
+// ZonesPagerFiltered returns a Pager over the zones matching the ZoneListFilter
+// criteria passed, such as every zone in the system when IgnoreAccess is set,
+// fetching pages lazily starting at filter.StartFrom.
+func (c *Client) ZonesPagerFiltered(ctx context.Context, filter ZoneListFilter) *Pager[Zone, string] {
+	return NewPager(ctx, filter.StartFrom, func(ctx context.Context, startFrom string) ([]Zone, string, error) {
+		if filter.MaxItems > 100 {
+			return nil, "", fmt.Errorf("MaxItems must be between 1 and 100")
+		}
+		filter.StartFrom = startFrom
+		resp, err := c.zonesList(ctx, filter)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.Zones, resp.NextID, nil
+	})
+}
+
+// ZonesIterFiltered returns an iterator over the zones matching the ZoneListFilter criteria passed.
+func (c *Client) ZonesIterFiltered(ctx context.Context, filter ZoneListFilter) iter.Seq2[Zone, error] {
+	return c.ZonesPagerFiltered(ctx, filter).All()
+}
+
 // Zone retrieves the Zone whose ID it's passed.
 func (c *Client) Zone(id string) (Zone, error) {
+	return c.ZoneWithContext(context.Background(), id)
+}
+
+// ZoneWithContext retrieves the Zone whose ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneWithContext(ctx context.Context, id string) (Zone, error) {
 	zone := &ZoneResponse{}
-	err := resourceRequest(c, zoneEP(c, id), "GET", nil, zone)
+	err := resourceRequest(ctx, c, zoneEP(c, id), "GET", nil, zone)
 	if err != nil {
 		return Zone{}, err
 	}
@@ -66,8 +147,14 @@ func (c *Client) Zone(id string) (Zone, error) {
 
 // Zone retrieves the Zone whose ID it's passed.
 func (c *Client) ZoneDetails(id string) (ZoneDetails, error) {
+	return c.ZoneDetailsWithContext(context.Background(), id)
+}
+
+// ZoneDetailsWithContext retrieves the ZoneDetails for the Zone whose ID
+// it's passed, using ctx for the lifetime of the request.
+func (c *Client) ZoneDetailsWithContext(ctx context.Context, id string) (ZoneDetails, error) {
 	zoneDetails := &ZoneDetailsResponse{}
-	err := resourceRequest(c, zoneDetailsEP(c, id), "GET", nil, zoneDetails)
+	err := resourceRequest(ctx, c, zoneDetailsEP(c, id), "GET", nil, zoneDetails)
 	if err != nil {
 		return ZoneDetails{}, err
 	}
@@ -77,8 +164,14 @@ func (c *Client) ZoneDetails(id string) (ZoneDetails, error) {
 
 // ZoneBackendIDs retrieves all configured DNS backend IDs.
 func (c *Client) ZoneBackendIDs() ([]string, error) {
+	return c.ZoneBackendIDsWithContext(context.Background())
+}
+
+// ZoneBackendIDsWithContext retrieves all configured DNS backend IDs,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneBackendIDsWithContext(ctx context.Context) ([]string, error) {
 	var ids []string
-	err := resourceRequest(c, zoneBackendIDsEP(c), "GET", nil, &ids)
+	err := resourceRequest(ctx, c, zoneBackendIDsEP(c), "GET", nil, &ids)
 	if err != nil {
 		return nil, err
 	}
@@ -92,10 +185,22 @@ func (c *Client) ZoneByID(id string) (Zone, error) {
 	return c.Zone(id)
 }
 
+// ZoneByIDWithContext retrieves the Zone whose ID it's passed.
+// It is a version of ZoneWithContext whose func name is a bit more explicit.
+func (c *Client) ZoneByIDWithContext(ctx context.Context, id string) (Zone, error) {
+	return c.ZoneWithContext(ctx, id)
+}
+
 // ZoneByName retrieves the Zone whose name it's passed.
 func (c *Client) ZoneByName(name string) (Zone, error) {
+	return c.ZoneByNameWithContext(context.Background(), name)
+}
+
+// ZoneByNameWithContext retrieves the Zone whose name it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneByNameWithContext(ctx context.Context, name string) (Zone, error) {
 	zone := &ZoneResponse{}
-	err := resourceRequest(c, zoneNameEP(c, name), "GET", nil, zone)
+	err := resourceRequest(ctx, c, zoneNameEP(c, name), "GET", nil, zone)
 	if err != nil {
 		return Zone{}, err
 	}
@@ -105,8 +210,14 @@ func (c *Client) ZoneByName(name string) (Zone, error) {
 
 // ZonesDeleted retrieves deleted zone information with the filter passed.
 func (c *Client) ZonesDeleted(filter DeletedZonesFilter) (*DeletedZonesResponse, error) {
+	return c.ZonesDeletedWithContext(context.Background(), filter)
+}
+
+// ZonesDeletedWithContext retrieves deleted zone information with the filter passed,
+// using ctx for the lifetime of the request.
+func (c *Client) ZonesDeletedWithContext(ctx context.Context, filter DeletedZonesFilter) (*DeletedZonesResponse, error) {
 	zones := &DeletedZonesResponse{}
-	err := resourceRequest(c, zoneDeletedChangesEP(c, filter), "GET", nil, zones)
+	err := resourceRequest(ctx, c, zoneDeletedChangesEP(c, filter), "GET", nil, zones)
 	if err != nil {
 		return &DeletedZonesResponse{}, err
 	}
@@ -116,12 +227,18 @@ func (c *Client) ZonesDeleted(filter DeletedZonesFilter) (*DeletedZonesResponse,
 
 // ZoneACLRuleCreate adds an ACL rule to the zone.
 func (c *Client) ZoneACLRuleCreate(zoneID string, rule *ACLRule) (*ZoneUpdateResponse, error) {
+	return c.ZoneACLRuleCreateWithContext(context.Background(), zoneID, rule)
+}
+
+// ZoneACLRuleCreateWithContext adds an ACL rule to the zone,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneACLRuleCreateWithContext(ctx context.Context, zoneID string, rule *ACLRule) (*ZoneUpdateResponse, error) {
 	ruleJSON, err := json.Marshal(rule)
 	if err != nil {
 		return nil, err
 	}
 	resource := &ZoneUpdateResponse{}
-	err = resourceRequest(c, zoneACLRulesEP(c, zoneID), "PUT", ruleJSON, resource)
+	err = resourceRequest(ctx, c, zoneACLRulesEP(c, zoneID), "PUT", ruleJSON, resource)
 	if err != nil {
 		return &ZoneUpdateResponse{}, err
 	}
@@ -131,12 +248,18 @@ func (c *Client) ZoneACLRuleCreate(zoneID string, rule *ACLRule) (*ZoneUpdateRes
 
 // ZoneACLRuleDelete deletes an ACL rule from the zone.
 func (c *Client) ZoneACLRuleDelete(zoneID string, rule *ACLRule) (*ZoneUpdateResponse, error) {
+	return c.ZoneACLRuleDeleteWithContext(context.Background(), zoneID, rule)
+}
+
+// ZoneACLRuleDeleteWithContext deletes an ACL rule from the zone,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneACLRuleDeleteWithContext(ctx context.Context, zoneID string, rule *ACLRule) (*ZoneUpdateResponse, error) {
 	ruleJSON, err := json.Marshal(rule)
 	if err != nil {
 		return nil, err
 	}
 	resource := &ZoneUpdateResponse{}
-	err = resourceRequest(c, zoneACLRulesEP(c, zoneID), "DELETE", ruleJSON, resource)
+	err = resourceRequest(ctx, c, zoneACLRulesEP(c, zoneID), "DELETE", ruleJSON, resource)
 	if err != nil {
 		return &ZoneUpdateResponse{}, err
 	}
@@ -146,12 +269,18 @@ func (c *Client) ZoneACLRuleDelete(zoneID string, rule *ACLRule) (*ZoneUpdateRes
 
 // ZoneCreate creates the Zone it's passed.
 func (c *Client) ZoneCreate(z *Zone) (*ZoneUpdateResponse, error) {
+	return c.ZoneCreateWithContext(context.Background(), z)
+}
+
+// ZoneCreateWithContext creates the Zone it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneCreateWithContext(ctx context.Context, z *Zone) (*ZoneUpdateResponse, error) {
 	zJSON, err := json.Marshal(z)
 	if err != nil {
 		return nil, err
 	}
 	var resource = &ZoneUpdateResponse{}
-	err = resourceRequest(c, zonesEP(c), "POST", zJSON, resource)
+	err = resourceRequest(ctx, c, zonesEP(c), "POST", zJSON, resource)
 	if err != nil {
 		return &ZoneUpdateResponse{}, err
 	}
@@ -161,12 +290,18 @@ func (c *Client) ZoneCreate(z *Zone) (*ZoneUpdateResponse, error) {
 
 // ZoneUpdate updates the Zone whose ID it's passed.
 func (c *Client) ZoneUpdate(z *Zone) (*ZoneUpdateResponse, error) {
+	return c.ZoneUpdateWithContext(context.Background(), z)
+}
+
+// ZoneUpdateWithContext updates the Zone whose ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneUpdateWithContext(ctx context.Context, z *Zone) (*ZoneUpdateResponse, error) {
 	zJSON, err := json.Marshal(z)
 	if err != nil {
 		return nil, err
 	}
 	var resource = &ZoneUpdateResponse{}
-	err = resourceRequest(c, zoneEP(c, z.ID), "PUT", zJSON, resource)
+	err = resourceRequest(ctx, c, zoneEP(c, z.ID), "PUT", zJSON, resource)
 	if err != nil {
 		return &ZoneUpdateResponse{}, err
 	}
@@ -176,8 +311,14 @@ func (c *Client) ZoneUpdate(z *Zone) (*ZoneUpdateResponse, error) {
 
 // ZoneDelete deletes the Zone whose ID it's passed.
 func (c *Client) ZoneDelete(zoneID string) (*ZoneUpdateResponse, error) {
+	return c.ZoneDeleteWithContext(context.Background(), zoneID)
+}
+
+// ZoneDeleteWithContext deletes the Zone whose ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneDeleteWithContext(ctx context.Context, zoneID string) (*ZoneUpdateResponse, error) {
 	resource := &ZoneUpdateResponse{}
-	err := resourceRequest(c, zoneEP(c, zoneID), "DELETE", nil, resource)
+	err := resourceRequest(ctx, c, zoneEP(c, zoneID), "DELETE", nil, resource)
 	if err != nil {
 		return &ZoneUpdateResponse{}, err
 	}
@@ -188,16 +329,20 @@ func (c *Client) ZoneDelete(zoneID string) (*ZoneUpdateResponse, error) {
 // ZoneExists returns true if a zone request does not 404
 // Otherwise, it returns false
 func (c *Client) ZoneExists(id string) (bool, error) {
+	return c.ZoneExistsWithContext(context.Background(), id)
+}
+
+// ZoneExistsWithContext returns true if a zone request does not 404
+// Otherwise, it returns false
+func (c *Client) ZoneExistsWithContext(ctx context.Context, id string) (bool, error) {
 	zone := &ZoneResponse{}
-	err := resourceRequest(c, zoneEP(c, id), "GET", nil, zone)
+	err := resourceRequest(ctx, c, zoneEP(c, id), "GET", nil, zone)
 	if err != nil {
-		if vErr, ok := err.(*Error); ok {
-			if vErr.ResponseCode == http.StatusNotFound {
-				return false, nil
-			}
-
-			return false, err
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
 		}
+
+		return false, err
 	}
 
 	return true, nil
@@ -206,16 +351,20 @@ func (c *Client) ZoneExists(id string) (bool, error) {
 // ZoneNameExists returns true if a zone request does not 404
 // Otherwise, it returns false
 func (c *Client) ZoneNameExists(name string) (bool, error) {
+	return c.ZoneNameExistsWithContext(context.Background(), name)
+}
+
+// ZoneNameExistsWithContext returns true if a zone request does not 404
+// Otherwise, it returns false
+func (c *Client) ZoneNameExistsWithContext(ctx context.Context, name string) (bool, error) {
 	zone := &ZoneResponse{}
-	err := resourceRequest(c, zoneNameEP(c, name), "GET", nil, zone)
+	err := resourceRequest(ctx, c, zoneNameEP(c, name), "GET", nil, zone)
 	if err != nil {
-		if vErr, ok := err.(*Error); ok {
-			if vErr.ResponseCode == http.StatusNotFound {
-				return false, nil
-			}
-
-			return false, err
+		if errors.Is(err, ErrNotFound) {
+			return false, nil
 		}
+
+		return false, err
 	}
 
 	return true, nil
@@ -223,8 +372,14 @@ func (c *Client) ZoneNameExists(name string) (bool, error) {
 
 // ZoneChanges retrieves the ZoneChanges for the Zone whose ID it's passed.
 func (c *Client) ZoneChanges(id string) (*ZoneChanges, error) {
+	return c.ZoneChangesWithContext(context.Background(), id)
+}
+
+// ZoneChangesWithContext retrieves the ZoneChanges for the Zone whose ID it's passed,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneChangesWithContext(ctx context.Context, id string) (*ZoneChanges, error) {
 	zh := &ZoneChanges{}
-	err := resourceRequest(c, zoneChangesEP(c, id, ListFilter{}), "GET", nil, zh)
+	err := resourceRequest(ctx, c, zoneChangesEP(c, id, ListFilter{}), "GET", nil, zh)
 	if err != nil {
 		return &ZoneChanges{}, err
 	}
@@ -234,8 +389,14 @@ func (c *Client) ZoneChanges(id string) (*ZoneChanges, error) {
 
 // ZoneChangesFailure retrieves failed zone changes with the filter passed.
 func (c *Client) ZoneChangesFailure(filter ListFilter) (*ZoneChangeFailuresResponse, error) {
+	return c.ZoneChangesFailureWithContext(context.Background(), filter)
+}
+
+// ZoneChangesFailureWithContext retrieves failed zone changes with the filter passed,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneChangesFailureWithContext(ctx context.Context, filter ListFilter) (*ZoneChangeFailuresResponse, error) {
 	failures := &ZoneChangeFailuresResponse{}
-	err := resourceRequest(c, zoneChangesFailureEP(c, filter), "GET", nil, failures)
+	err := resourceRequest(ctx, c, zoneChangesFailureEP(c, filter), "GET", nil, failures)
 	if err != nil {
 		return &ZoneChangeFailuresResponse{}, err
 	}
@@ -246,6 +407,12 @@ func (c *Client) ZoneChangesFailure(filter ListFilter) (*ZoneChangeFailuresRespo
 // ZoneChangesListAll retrieves the complete list of zone changes with the ListFilter criteria passed.
 // Handles paging through results on the user's behalf.
 func (c *Client) ZoneChangesListAll(zoneID string, filter ListFilter) ([]ZoneChange, error) {
+	return c.ZoneChangesListAllWithContext(context.Background(), zoneID, filter)
+}
+
+// ZoneChangesListAllWithContext is ZoneChangesListAll with a context.Context
+// that is applied to each page request.
+func (c *Client) ZoneChangesListAllWithContext(ctx context.Context, zoneID string, filter ListFilter) ([]ZoneChange, error) {
 	if filter.MaxItems > 100 {
 		return nil, fmt.Errorf("MaxItems must be between 1 and 100")
 	}
@@ -253,7 +420,7 @@ func (c *Client) ZoneChangesListAll(zoneID string, filter ListFilter) ([]ZoneCha
 	changes := []ZoneChange{}
 
 	for {
-		resp, err := c.zoneChangesList(zoneID, filter)
+		resp, err := c.zoneChangesList(ctx, zoneID, filter)
 		if err != nil {
 			return nil, err
 		}
@@ -267,11 +434,38 @@ func (c *Client) ZoneChangesListAll(zoneID string, filter ListFilter) ([]ZoneCha
 	}
 }
 
+// ZoneChangesPager returns a Pager over the changes of the zone whose ID it's passed,
+// fetching pages lazily starting at filter.StartFrom.
+func (c *Client) ZoneChangesPager(ctx context.Context, zoneID string, filter ListFilter) *Pager[ZoneChange, string] {
+	return NewPager(ctx, filter.StartFrom, func(ctx context.Context, startFrom string) ([]ZoneChange, string, error) {
+		if filter.MaxItems > 100 {
+			return nil, "", fmt.Errorf("MaxItems must be between 1 and 100")
+		}
+		filter.StartFrom = startFrom
+		resp, err := c.zoneChangesList(ctx, zoneID, filter)
+		if err != nil {
+			return nil, "", err
+		}
+		return resp.ZoneChanges, resp.NextID, nil
+	})
+}
+
+// ZoneChangesIter returns an iterator over the changes of the zone whose ID it's passed.
+func (c *Client) ZoneChangesIter(ctx context.Context, zoneID string, filter ListFilter) iter.Seq2[ZoneChange, error] {
+	return c.ZoneChangesPager(ctx, zoneID, filter).All()
+}
+
 // ZoneChange retrieves the ZoneChange matching the Zone ID and
 // and ZoneChange ID it's passed.
 func (c *Client) ZoneChange(zoneID, zoneChangeID string) (ZoneChange, error) {
+	return c.ZoneChangeWithContext(context.Background(), zoneID, zoneChangeID)
+}
+
+// ZoneChangeWithContext retrieves the ZoneChange matching the Zone ID and
+// and ZoneChange ID it's passed, using ctx for the lifetime of the request.
+func (c *Client) ZoneChangeWithContext(ctx context.Context, zoneID, zoneChangeID string) (ZoneChange, error) {
 	zc := ZoneChange{}
-	history, err := c.ZoneChanges(zoneID)
+	history, err := c.ZoneChangesWithContext(ctx, zoneID)
 	if err != nil {
 		return zc, err
 	}
@@ -288,8 +482,14 @@ func (c *Client) ZoneChange(zoneID, zoneChangeID string) (ZoneChange, error) {
 
 // ZoneSync triggers the sync process of VinyIDNS zone info with existing zone
 func (c *Client) ZoneSync(zoneId string) (ZoneChange, error) {
+	return c.ZoneSyncWithContext(context.Background(), zoneId)
+}
+
+// ZoneSyncWithContext triggers the sync process of VinyIDNS zone info with existing zone,
+// using ctx for the lifetime of the request.
+func (c *Client) ZoneSyncWithContext(ctx context.Context, zoneId string) (ZoneChange, error) {
 	zc := ZoneChange{}
-	err := resourceRequest(c, zoneSyncEP(c, zoneId), "POST", nil, &zc)
+	err := resourceRequest(ctx, c, zoneSyncEP(c, zoneId), "POST", nil, &zc)
 	if err != nil {
 		return zc, err
 	}
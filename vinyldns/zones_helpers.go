@@ -12,10 +12,12 @@ limitations under the License.
 
 package vinyldns
 
-// zonesList retrieves the list of zones with the List criteria passed.
-func (c *Client) zonesList(filter ListFilter) (*Zones, error) {
+import "context"
+
+// zonesList retrieves the list of zones with the ZoneListFilter criteria passed.
+func (c *Client) zonesList(ctx context.Context, filter ZoneListFilter) (*Zones, error) {
 	zones := &Zones{}
-	err := resourceRequest(c, zonesListEP(c, filter), "GET", nil, zones)
+	err := resourceRequest(ctx, c, zonesListEP(c, filter), "GET", nil, zones)
 	if err != nil {
 		return zones, err
 	}
@@ -23,10 +25,19 @@ func (c *Client) zonesList(filter ListFilter) (*Zones, error) {
 	return zones, nil
 }
 
+// zoneListFilter returns the ZoneListFilter of the ListFilter it's passed.
+func zoneListFilter(f ListFilter) ZoneListFilter {
+	return ZoneListFilter{
+		NameFilter: f.NameFilter,
+		StartFrom:  f.StartFrom,
+		MaxItems:   f.MaxItems,
+	}
+}
+
 // zoneChangesList retrieves the list of zone changes with the List criteria passed.
-func (c *Client) zoneChangesList(zoneID string, filter ListFilter) (*ZoneChanges, error) {
+func (c *Client) zoneChangesList(ctx context.Context, zoneID string, filter ListFilter) (*ZoneChanges, error) {
 	changes := &ZoneChanges{}
-	err := resourceRequest(c, zoneChangesEP(c, zoneID, filter), "GET", nil, changes)
+	err := resourceRequest(ctx, c, zoneChangesEP(c, zoneID, filter), "GET", nil, changes)
 	if err != nil {
 		return changes, err
 	}